@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// NewActivityService initialises a new ActivityService
+func NewActivityService(s *Service) *ActivityService {
+	rs := &ActivityService{s: s}
+	rs.activities = model.NewActivityCollection()
+	return rs
+}
+
+// ActivityService is used for calling the Glass Factory activity APIs
+type ActivityService struct {
+	s          *Service
+	activities *model.ActivityCollection
+}
+
+// All returns all activities in the Glass Factory account
+func (r *ActivityService) All(opts ...RequestOption) ([]*model.Activity, error) {
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all activities in the Glass Factory account using the given context
+func (r *ActivityService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Activity, error) {
+	res, err := r.List(opts...).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return res.Activities, nil
+}
+
+// Get returns an activity from Glass Factory
+func (r *ActivityService) Get(activityID int, opts ...RequestOption) (*model.Activity, error) {
+	return r.GetContext(r.s.defaultContext(), activityID, opts...)
+}
+
+// GetContext returns an activity from Glass Factory using the given context
+func (r *ActivityService) GetContext(ctx context.Context, activityID int, opts ...RequestOption) (*model.Activity, error) {
+	options := NewRequestOptions(opts)
+	if options.cache {
+		activity, ok := r.activities.Get(activityID)
+		if ok {
+			return activity, nil
+		}
+	}
+	res, err := r.Details(activityID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if options.cache {
+		r.activities.Add(res.Activity)
+	}
+	return res.Activity, nil
+}
+
+// Details returns activity details from Glass Factory
+func (r *ActivityService) Details(activityID int) *ActivityDetailsCall {
+	c := &ActivityDetailsCall{s: r.s}
+	c.activityID = activityID
+	return c
+}
+
+// List returns a list of activities in the Glass Factory account
+func (r *ActivityService) List(opts ...RequestOption) *ActivityListCall {
+	c := &ActivityListCall{s: r.s}
+	c.options = opts
+	return c
+}
+
+// ActivityDetailsCall represents a request to Activity Details API
+type ActivityDetailsCall struct {
+	s          *Service
+	ctx        context.Context
+	activityID int
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *ActivityDetailsCall) Context(ctx context.Context) *ActivityDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
+// ActivityDetailsResponse represents a response from Activity Details API
+type ActivityDetailsResponse struct {
+	Activity *model.Activity
+}
+
+func (c *ActivityDetailsCall) doRequest() (*http.Response, error) {
+	var urls string
+	if c.activityID > 0 {
+		urls = c.s.BasePath + fmt.Sprintf("activities/%d.json", c.activityID)
+	} else {
+		return nil, errors.New("activity ID is required")
+	}
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *ActivityDetailsCall) Do() (*ActivityDetailsResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	var target model.Activity
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &ActivityDetailsResponse{}
+	ret.Activity = &target
+	return ret, nil
+}
+
+// ActivityListCall represents a request to List Account's Activities API
+type ActivityListCall struct {
+	s       *Service
+	ctx     context.Context
+	options []RequestOption
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *ActivityListCall) Context(ctx context.Context) *ActivityListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Options returns request options with defaults
+func (c *ActivityListCall) Options() RequestOptions {
+	options := RequestOptions{}
+	options.apply(c.options)
+	return options
+}
+
+// ActivityListResponse represents a response from List Account's Activities API
+type ActivityListResponse struct {
+	Activities []*model.Activity
+}
+
+func (c *ActivityListCall) doRequest() (*http.Response, error) {
+	var urls string
+	urls = c.s.BasePath + "activities.json"
+
+	options := c.Options()
+
+	urlParams := url.Values{}
+	if options.term != "" {
+		urlParams.Add("term", options.term)
+	}
+	urls += "?" + urlParams.Encode()
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *ActivityListCall) Do() (*ActivityListResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	target := make([]*model.Activity, 0)
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &ActivityListResponse{}
+	ret.Activities = target
+	return ret, nil
+}
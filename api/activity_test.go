@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestGetActivity(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+	activityID := 42
+
+	gock.New(domain).
+		Get(apiPath + fmt.Sprintf("activities/%d.json", activityID)).
+		Reply(200).
+		BodyString(`{
+			"id": 42,
+			"name": "Meetings"
+		}`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewActivityService(s)
+
+	activity, err := rs.Get(activityID)
+	assert.NilError(t, err)
+	assert.Equal(t, activity.ID, activityID)
+	assert.Equal(t, activity.Name, "Meetings")
+
+	// Second call is served from the cache
+	cached, err := rs.Get(activityID)
+	assert.NilError(t, err)
+	assert.Equal(t, cached, activity)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestListActivities(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+
+	gock.New(domain).
+		Get(apiPath + "activities.json").
+		Reply(200).
+		BodyString(`[
+			{"id": 42, "name": "Meetings"},
+			{"id": 43, "name": "Design"}
+		]`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewActivityService(s)
+
+	activities, err := rs.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(activities), 2)
+	assert.Equal(t, activities[1].Name, "Design")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
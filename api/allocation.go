@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// NewAllocationService initialises a new AllocationService
+func NewAllocationService(s *Service) *AllocationService {
+	return &AllocationService{s: s}
+}
+
+// AllocationService is used for calling the Glass Factory resource booking APIs
+type AllocationService struct {
+	s *Service
+}
+
+// GetMemberAllocations returns the planned allocations of a member between given dates
+func (r *AllocationService) GetMemberAllocations(ctx context.Context, userID int, start time.Time, end time.Time) ([]*model.Allocation, error) {
+	res, err := r.List(start, end).Member(userID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return res.Allocations, nil
+}
+
+// GetProjectAllocations returns the planned allocations on a project between given dates
+func (r *AllocationService) GetProjectAllocations(ctx context.Context, projectID int, start time.Time, end time.Time) ([]*model.Allocation, error) {
+	res, err := r.List(start, end).Project(projectID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return res.Allocations, nil
+}
+
+// List returns a list of allocations overlapping the given dates
+func (r *AllocationService) List(start time.Time, end time.Time) *AllocationListCall {
+	c := &AllocationListCall{s: r.s}
+	c.start = civil.DateOf(start)
+	c.end = civil.DateOf(end)
+	return c
+}
+
+// AllocationListCall represents a request to List Allocations API
+type AllocationListCall struct {
+	s         *Service
+	ctx       context.Context
+	start     civil.Date
+	end       civil.Date
+	userID    int
+	projectID int
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *AllocationListCall) Context(ctx context.Context) *AllocationListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Member returns only allocations of the given member
+func (c *AllocationListCall) Member(userID int) *AllocationListCall {
+	c.userID = userID
+	return c
+}
+
+// Project returns only allocations on the given project
+func (c *AllocationListCall) Project(projectID int) *AllocationListCall {
+	c.projectID = projectID
+	return c
+}
+
+// AllocationListResponse represents a response from List Allocations API
+type AllocationListResponse struct {
+	Allocations []*model.Allocation
+}
+
+func (c *AllocationListCall) doRequest() (*http.Response, error) {
+	if !c.start.IsValid() || !c.end.IsValid() {
+		return nil, errors.New("start and end dates are required")
+	}
+	if c.end.Before(c.start) {
+		return nil, errors.New("end date can't be before the start date")
+	}
+	urls := c.s.BasePath + "allocations.json"
+
+	urlParams := url.Values{}
+	urlParams.Add("start", c.start.String())
+	urlParams.Add("end", c.end.String())
+	if c.userID > 0 {
+		urlParams.Add("user_id", strconv.Itoa(c.userID))
+	}
+	if c.projectID > 0 {
+		urlParams.Add("project_id", strconv.Itoa(c.projectID))
+	}
+	urls += "?" + urlParams.Encode()
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *AllocationListCall) Do() (*AllocationListResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	target := make([]*model.Allocation, 0)
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &AllocationListResponse{}
+	ret.Allocations = target
+	return ret, nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestGetMemberAllocations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/allocations.json").
+		MatchParam("start", "2019-09-01").
+		MatchParam("end", "2019-09-30").
+		MatchParam("user_id", "123").
+		Reply(200).
+		BodyString(`[
+		  {
+			"id": 55,
+			"user_id": 123,
+			"project_id": 222,
+			"start_date": "2019-09-02",
+			"end_date": "2019-09-13",
+			"hours": 6
+		  }
+		]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	start := time.Date(2019, time.September, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 30, 0, 0, 0, 0, time.UTC)
+	allocations, err := s.Allocation.GetMemberAllocations(context.Background(), 123, start, end)
+	assert.NilError(t, err)
+	assert.Equal(t, len(allocations), 1)
+	assert.Equal(t, allocations[0].ProjectID, 222)
+	assert.Equal(t, allocations[0].TotalHours(), 60.0)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestGetProjectAllocations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/allocations.json").
+		MatchParam("project_id", "222").
+		Reply(200).
+		BodyString(`[
+		  {"id": 55, "user_id": 123, "project_id": 222, "start_date": "2019-09-02", "end_date": "2019-09-13", "hours": 6},
+		  {"id": 56, "user_id": 456, "project_id": 222, "start_date": "2019-09-02", "end_date": "2019-09-06", "hours": 8}
+		]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	start := time.Date(2019, time.September, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 30, 0, 0, 0, 0, time.UTC)
+	allocations, err := s.Allocation.GetProjectAllocations(context.Background(), 222, start, end)
+	assert.NilError(t, err)
+	assert.Equal(t, len(allocations), 2)
+	assert.Equal(t, allocations[1].UserID, 456)
+
+	_, err = s.Allocation.List(end, start).Do()
+	assert.Error(t, err, "end date can't be before the start date")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
@@ -0,0 +1,386 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/101loops/clock"
+	"github.com/jinzhu/now"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// NoExpiry can be used as a cache TTL for responses that never expire
+const NoExpiry time.Duration = -1
+
+// ErrNotCached is returned in offline mode when the response is not in the cache
+var ErrNotCached = errors.New("response not found in cache")
+
+// CacheEntry represents a cached API response body
+type CacheEntry struct {
+	Key     string            `json:"key,omitempty"` // Cache key of the entry
+	Data    []byte            `json:"data"`
+	Header  map[string]string `json:"header,omitempty"`  // Pagination headers of the response
+	Expires time.Time         `json:"expires,omitempty"` // Zero value means the entry never expires
+}
+
+// Expired reports whether the entry has expired at the given time
+func (e *CacheEntry) Expired(t time.Time) bool {
+	return !e.Expires.IsZero() && !t.Before(e.Expires)
+}
+
+// Cache stores API responses
+type Cache interface {
+	// Get returns a cached entry, including expired entries
+	Get(key string) (*CacheEntry, bool)
+	// Set stores an entry in the cache
+	Set(key string, entry *CacheEntry) error
+	// Delete removes an entry from the cache
+	Delete(key string) error
+	// DeleteFunc removes the entries with keys matching the function
+	DeleteFunc(match func(key string) bool) error
+}
+
+// CacheTTL defines how long responses for each resource type are cached.
+// Zero disables caching for the resource and NoExpiry keeps responses forever.
+type CacheTTL struct {
+	Clients         time.Duration
+	Members         time.Duration
+	Projects        time.Duration
+	Offices         time.Duration
+	Roles           time.Duration
+	Activities      time.Duration
+	TimeReports     time.Duration // Time reports including the current month
+	PastTimeReports time.Duration // Time reports ending before the current month
+}
+
+// DefaultCacheTTL returns the cache TTLs used when none have been configured
+func DefaultCacheTTL() *CacheTTL {
+	return &CacheTTL{
+		Clients:         24 * time.Hour,
+		Members:         24 * time.Hour,
+		Projects:        time.Hour,
+		Offices:         24 * time.Hour,
+		Roles:           24 * time.Hour,
+		Activities:      24 * time.Hour,
+		TimeReports:     5 * time.Minute,
+		PastTimeReports: NoExpiry,
+	}
+}
+
+// DefaultCacheDir returns the directory used for caching API responses
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "glassfactory"), nil
+}
+
+// FileCache stores cached entries as files in a directory
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache creates a new FileCache in the given directory
+func NewFileCache(dir string) (*FileCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache directory is required")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+// Dir returns the cache directory
+func (c *FileCache) Dir() string {
+	return c.dir
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns a cached entry from the cache directory
+func (c *FileCache) Get(key string) (*CacheEntry, bool) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// Set writes an entry into the cache directory
+func (c *FileCache) Set(key string, entry *CacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), c.path(key))
+}
+
+// Delete removes an entry from the cache directory
+func (c *FileCache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// DeleteFunc removes the entries with keys matching the function from the
+// cache directory
+func (c *FileCache) DeleteFunc(match func(key string) bool) error {
+	return c.deleteEntries(func(entry *CacheEntry) bool {
+		return entry != nil && match(entry.Key)
+	})
+}
+
+// Prune removes entries that expired before the given time from the cache
+// directory. Entries that can't be read are removed as well.
+func (c *FileCache) Prune(before time.Time) error {
+	return c.deleteEntries(func(entry *CacheEntry) bool {
+		return entry == nil || entry.Expired(before)
+	})
+}
+
+// deleteEntries removes the entries matching the function from the cache
+// directory. Entries that can't be read are passed to the function as nil.
+func (c *FileCache) deleteEntries(match func(entry *CacheEntry) bool) error {
+	files, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		data, err := ioutil.ReadFile(name)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		entry := &CacheEntry{}
+		if err := json.Unmarshal(data, entry); err != nil {
+			entry = nil
+		}
+		if !match(entry) {
+			continue
+		}
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// CacheTransport serves GET requests from the cache and stores successful
+// responses in it. Successful time entry changes remove the cached time
+// reports of the member.
+type CacheTransport struct {
+	Base    http.RoundTripper // Transport used for the requests, defaults to http.DefaultTransport
+	Cache   Cache
+	TTL     CacheTTL
+	Offline bool // Serve responses only from the cache, including expired entries
+
+	clock clock.Clock
+}
+
+// NewCacheTransport creates a new CacheTransport
+func NewCacheTransport(base http.RoundTripper, cache Cache, ttl CacheTTL, offline bool) *CacheTransport {
+	return &CacheTransport{
+		Base:    base,
+		Cache:   cache,
+		TTL:     ttl,
+		Offline: offline,
+		clock:   clock.New(),
+	}
+}
+
+func (t *CacheTransport) now() time.Time {
+	if t.clock == nil {
+		return time.Now()
+	}
+	return t.clock.Now()
+}
+
+// RoundTrip returns a cached response if available or executes the request
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if t.Offline {
+			return nil, fmt.Errorf("%s %s: can't send requests in offline mode", req.Method, req.URL)
+		}
+		res, err := t.base().RoundTrip(req)
+		if err == nil && res.StatusCode >= 200 && res.StatusCode < 300 {
+			// Failing to invalidate the cache shouldn't fail the request
+			_ = t.invalidate(req)
+		}
+		return res, err
+	}
+	key := t.key(req)
+	ttl := t.ttl(req)
+	if entry, ok := t.Cache.Get(key); ok {
+		if t.Offline || (ttl != 0 && !entry.Expired(t.now())) {
+			return newCachedResponse(req, entry), nil
+		}
+	}
+	if t.Offline {
+		return nil, fmt.Errorf("%s: %w", req.URL, ErrNotCached)
+	}
+	res, err := t.base().RoundTrip(req)
+	if err != nil || ttl == 0 || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	entry := &CacheEntry{Key: key, Data: data}
+	for _, key := range paginationHeaders {
+		if value := res.Header.Get(key); value != "" {
+			if entry.Header == nil {
+				entry.Header = make(map[string]string)
+			}
+			entry.Header[key] = value
+		}
+	}
+	if ttl > 0 {
+		entry.Expires = t.now().Add(ttl)
+	}
+	// Failing to write into the cache shouldn't fail the request
+	_ = t.Cache.Set(key, entry)
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+	return res, nil
+}
+
+func (t *CacheTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// timeEntriesPath matches the time entry paths of a member
+var timeEntriesPath = regexp.MustCompile(`^(.*/members/\d+/)time_entries(/\d+)?\.json$`)
+
+// invalidate removes the cached time reports of the member whose time entries
+// were changed by the request
+func (t *CacheTransport) invalidate(req *http.Request) error {
+	m := timeEntriesPath.FindStringSubmatch(req.URL.Path)
+	if m == nil {
+		return nil
+	}
+	reportsPath := m[1] + "reports/time.json"
+	return t.Cache.DeleteFunc(func(key string) bool {
+		u, err := url.Parse(key)
+		return err == nil && u.Host == req.URL.Host && u.Path == reportsPath
+	})
+}
+
+// key returns the cache key for the request. Time reports ending today are keyed
+// on "today" instead of the date, as reports for the current month are requested
+// up to the current date and the end date moves forward every day.
+func (t *CacheTransport) key(req *http.Request) string {
+	if !strings.HasSuffix(req.URL.Path, "/reports/time.json") {
+		return req.URL.String()
+	}
+	query := req.URL.Query()
+	if query.Get("end") != dateutil.DateOf(t.now()).String() {
+		return req.URL.String()
+	}
+	query.Set("end", "today")
+	u := *req.URL
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// ttl returns the cache TTL for the requested resource
+func (t *CacheTransport) ttl(req *http.Request) time.Duration {
+	path := req.URL.Path
+	switch {
+	case strings.HasSuffix(path, "/reports/time.json"):
+		if t.isPastReport(req) {
+			return t.TTL.PastTimeReports
+		}
+		return t.TTL.TimeReports
+	case strings.Contains(path, "/clients"):
+		return t.TTL.Clients
+	case strings.Contains(path, "/members"):
+		return t.TTL.Members
+	case strings.Contains(path, "/projects"):
+		return t.TTL.Projects
+	case strings.Contains(path, "/offices"):
+		return t.TTL.Offices
+	case strings.Contains(path, "/roles"):
+		return t.TTL.Roles
+	case strings.Contains(path, "/activities"):
+		return t.TTL.Activities
+	}
+	return 0
+}
+
+// isPastReport reports whether the time report request ends before the current month
+func (t *CacheTransport) isPastReport(req *http.Request) bool {
+	query := req.URL.Query()
+	end := query.Get("end")
+	if end == "" {
+		end = query.Get("date")
+	}
+	d, err := dateutil.ParseDate(end)
+	if err != nil {
+		return false
+	}
+	currentMonth := dateutil.DateOf(now.With(t.now()).BeginningOfMonth())
+	return d.Before(currentMonth)
+}
+
+func newCachedResponse(req *http.Request, entry *CacheEntry) *http.Response {
+	data := entry.Data
+	header := http.Header{}
+	for key, value := range entry.Header {
+		header.Set(key, value)
+	}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-From-Cache", "1")
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/101loops/clock"
+	"gotest.tools/assert"
+)
+
+func newTestFileCache(t *testing.T) *FileCache {
+	dir, err := ioutil.TempDir("", "glassfactory-cache")
+	assert.NilError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	cache, err := NewFileCache(dir)
+	assert.NilError(t, err)
+	return cache
+}
+
+func TestFileCache(t *testing.T) {
+	cache := newTestFileCache(t)
+
+	_, ok := cache.Get("missing")
+	assert.Assert(t, !ok)
+
+	expires := time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC)
+	err := cache.Set("key", &CacheEntry{Data: []byte(`{"id": 1}`), Expires: expires})
+	assert.NilError(t, err)
+
+	entry, ok := cache.Get("key")
+	assert.Assert(t, ok)
+	assert.Equal(t, string(entry.Data), `{"id": 1}`)
+	assert.Assert(t, entry.Expires.Equal(expires))
+	assert.Assert(t, !entry.Expired(expires.Add(-time.Second)))
+	assert.Assert(t, entry.Expired(expires))
+
+	assert.NilError(t, cache.Delete("key"))
+	assert.NilError(t, cache.Delete("key"))
+	_, ok = cache.Get("key")
+	assert.Assert(t, !ok)
+}
+
+func TestFileCache_Prune(t *testing.T) {
+	cache := newTestFileCache(t)
+	expires := time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC)
+	assert.NilError(t, cache.Set("expired", &CacheEntry{Expires: expires}))
+	assert.NilError(t, cache.Set("stale", &CacheEntry{Expires: expires.Add(time.Hour)}))
+	assert.NilError(t, cache.Set("forever", &CacheEntry{}))
+
+	assert.NilError(t, cache.Prune(expires.Add(time.Minute)))
+
+	_, ok := cache.Get("expired")
+	assert.Assert(t, !ok)
+	_, ok = cache.Get("stale")
+	assert.Assert(t, ok, "entries expiring after the given time are kept")
+	_, ok = cache.Get("forever")
+	assert.Assert(t, ok)
+}
+
+func TestCacheTransport(t *testing.T) {
+	endpoint := "https://example.glassfactory.io/api/public/v1/"
+	today := time.Date(2019, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	var requests int
+	status := http.StatusOK
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return newStatusResponse(status, nil), nil
+	})
+
+	get := func(trans http.RoundTripper, path string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, endpoint+path, nil)
+		assert.NilError(t, err)
+		return trans.RoundTrip(req)
+	}
+
+	cache := newTestFileCache(t)
+	mock := clock.NewMock().FreezeAt(today)
+	trans := NewCacheTransport(base, cache, *DefaultCacheTTL(), false)
+	trans.clock = mock
+
+	t.Run("cache responses until they expire", func(t *testing.T) {
+		requests = 0
+		for i := 0; i < 3; i++ {
+			res, err := get(trans, "projects.json")
+			assert.NilError(t, err)
+			assert.Equal(t, res.StatusCode, http.StatusOK)
+		}
+		assert.Equal(t, requests, 1)
+
+		mock.Add(2 * time.Hour)
+		_, err := get(trans, "projects.json")
+		assert.NilError(t, err)
+		assert.Equal(t, requests, 2)
+	})
+
+	t.Run("past time reports never expire", func(t *testing.T) {
+		requests = 0
+		path := "members/1/reports/time.json?end=2019-09-30&start=2019-09-01"
+		_, err := get(trans, path)
+		assert.NilError(t, err)
+		mock.Add(365 * 24 * time.Hour)
+		res, err := get(trans, path)
+		assert.NilError(t, err)
+		assert.Equal(t, res.Header.Get("X-From-Cache"), "1")
+		assert.Equal(t, requests, 1)
+	})
+
+	t.Run("pagination headers are cached", func(t *testing.T) {
+		requests = 0
+		link := `</api/public/v1/roles.json?page=2>; rel="next"`
+		paged := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return newStatusResponse(http.StatusOK, http.Header{"Link": {link}}), nil
+		})
+		trans := NewCacheTransport(paged, cache, *DefaultCacheTTL(), false)
+		trans.clock = mock
+		for i := 0; i < 2; i++ {
+			res, err := get(trans, "roles.json")
+			assert.NilError(t, err)
+			assert.Equal(t, res.Header.Get("Link"), link)
+		}
+		assert.Equal(t, requests, 1)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		requests = 0
+		status = http.StatusInternalServerError
+		defer func() { status = http.StatusOK }()
+		for i := 0; i < 2; i++ {
+			res, err := get(trans, "clients.json")
+			assert.NilError(t, err)
+			assert.Equal(t, res.StatusCode, http.StatusInternalServerError)
+		}
+		assert.Equal(t, requests, 2)
+	})
+
+	t.Run("time reports ending today are keyed on today", func(t *testing.T) {
+		requests = 0
+		mock.FreezeAt(today)
+		_, err := get(trans, "members/1/reports/time.json?end=2019-10-15&start=2019-10-01")
+		assert.NilError(t, err)
+
+		// Different ranges in the same month are cached separately
+		res, err := get(trans, "members/1/reports/time.json?end=2019-10-10&start=2019-10-01")
+		assert.NilError(t, err)
+		assert.Equal(t, res.Header.Get("X-From-Cache"), "")
+		assert.Equal(t, requests, 2)
+
+		// The report end date moves forward the next day
+		mock.Add(24 * time.Hour)
+		offline := NewCacheTransport(base, cache, *DefaultCacheTTL(), true)
+		offline.clock = mock
+		res, err = get(offline, "members/1/reports/time.json?end=2019-10-16&start=2019-10-01")
+		assert.NilError(t, err)
+		assert.Equal(t, res.Header.Get("X-From-Cache"), "1")
+
+		res, err = get(offline, "members/1/reports/time.json?end=2019-10-10&start=2019-10-01")
+		assert.NilError(t, err)
+		assert.Equal(t, res.Header.Get("X-From-Cache"), "1")
+
+		_, err = get(offline, "members/1/reports/time.json?end=2019-10-31&start=2019-10-01")
+		assert.Assert(t, errors.Is(err, ErrNotCached))
+		assert.Equal(t, requests, 2)
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		requests = 0
+		offline := NewCacheTransport(base, cache, *DefaultCacheTTL(), true)
+		offline.clock = mock
+		mock.Add(365 * 24 * time.Hour)
+
+		res, err := get(offline, "projects.json")
+		assert.NilError(t, err)
+		assert.Equal(t, res.Header.Get("X-From-Cache"), "1")
+
+		_, err = get(offline, "members.json")
+		assert.Assert(t, errors.Is(err, ErrNotCached))
+		assert.Equal(t, requests, 0)
+	})
+}
+
+func TestCacheTransport_TTL(t *testing.T) {
+	trans := NewCacheTransport(nil, nil, *DefaultCacheTTL(), false)
+	trans.clock = clock.NewMock().FreezeAt(time.Date(2019, time.October, 15, 0, 0, 0, 0, time.UTC))
+	ttl := DefaultCacheTTL()
+
+	var tests = []struct {
+		path     string
+		expected time.Duration
+	}{
+		{path: "clients.json", expected: ttl.Clients},
+		{path: "clients/1.json", expected: ttl.Clients},
+		{path: "members/active.json", expected: ttl.Members},
+		{path: "projects/1.json", expected: ttl.Projects},
+		{path: "members/1/reports/time.json?start=2019-09-01&end=2019-09-30", expected: ttl.PastTimeReports},
+		{path: "members/1/reports/time.json?start=2019-10-01&end=2019-10-15", expected: ttl.TimeReports},
+		{path: "unknown.json", expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "https://example.glassfactory.io/api/public/v1/"+tt.path, nil)
+			assert.NilError(t, err)
+			assert.Equal(t, trans.ttl(req), tt.expected)
+		})
+	}
+}
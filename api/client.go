@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -24,15 +25,29 @@ type ClientService struct {
 
 // All returns all clients in the Glass Factory account
 func (r *ClientService) All(opts ...RequestOption) ([]*model.Client, error) {
-	res, err := r.List(opts...).Do()
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all clients in the Glass Factory account using the given context
+func (r *ClientService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Client, error) {
+	clients := make([]*model.Client, 0)
+	err := r.List(opts...).Pages(ctx, func(res *ClientListResponse) error {
+		clients = append(clients, res.Clients...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return res.Clients, nil
+	return clients, nil
 }
 
 // Get returns a client from Glass Factory
 func (r *ClientService) Get(clientID int, opts ...RequestOption) (*model.Client, error) {
+	return r.GetContext(r.s.defaultContext(), clientID, opts...)
+}
+
+// GetContext returns a client from Glass Factory using the given context
+func (r *ClientService) GetContext(ctx context.Context, clientID int, opts ...RequestOption) (*model.Client, error) {
 	options := NewRequestOptions(opts)
 	if options.cache {
 		client, ok := r.clients.Get(clientID)
@@ -40,7 +55,7 @@ func (r *ClientService) Get(clientID int, opts ...RequestOption) (*model.Client,
 			return client, nil
 		}
 	}
-	res, err := r.Details(clientID).Do()
+	res, err := r.Details(clientID).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -67,9 +82,16 @@ func (r *ClientService) List(opts ...RequestOption) *ClientListCall {
 // ClientDetailsCall represents a request to Client Details API
 type ClientDetailsCall struct {
 	s        *Service
+	ctx      context.Context
 	clientID int
 }
 
+// Context sets the context to be used in this call's Do method
+func (c *ClientDetailsCall) Context(ctx context.Context) *ClientDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
 // ClientDetailsResponse represents a response from Client Details API
 type ClientDetailsResponse struct {
 	Client *model.Client
@@ -83,12 +105,11 @@ func (c *ClientDetailsCall) doRequest() (*http.Response, error) {
 		return nil, errors.New("client ID is required")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -103,6 +124,10 @@ func (c *ClientDetailsCall) Do() (*ClientDetailsResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	var target model.Client
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
@@ -115,7 +140,54 @@ func (c *ClientDetailsCall) Do() (*ClientDetailsResponse, error) {
 // ClientListCall represents a request to List Account's Clients API
 type ClientListCall struct {
 	s       *Service
+	ctx     context.Context
 	options []RequestOption
+	page    int
+	pageURL string
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *ClientListCall) Context(ctx context.Context) *ClientListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Page sets the page of results to return in this call's Do method
+func (c *ClientListCall) Page(page int) *ClientListCall {
+	c.page = page
+	c.pageURL = ""
+	return c
+}
+
+// Pages fetches all pages of results, calling f for each page
+func (c *ClientListCall) Pages(ctx context.Context, f func(*ClientListResponse) error) error {
+	c.ctx = ctx
+	defer func(pageURL string) { c.pageURL = pageURL }(c.pageURL)
+	// Stop if the server links to a page that has already been fetched
+	fetched := make(map[string]bool)
+	var prev []int
+	for {
+		res, err := c.Do()
+		if err != nil {
+			return err
+		}
+		ids := make([]int, len(res.Clients))
+		for i, item := range res.Clients {
+			ids[i] = item.ID
+		}
+		if prev != nil && samePage(ids, prev) {
+			return nil
+		}
+		prev = ids
+		if err := f(res); err != nil {
+			return err
+		}
+		if res.NextPageURL == "" || fetched[res.NextPageURL] {
+			return nil
+		}
+		fetched[res.NextPageURL] = true
+		c.pageURL = res.NextPageURL
+	}
 }
 
 // Options returns request options with defaults
@@ -127,7 +199,8 @@ func (c *ClientListCall) Options() RequestOptions {
 
 // ClientListResponse represents a response from List Account's Clients API
 type ClientListResponse struct {
-	Clients []*model.Client
+	Clients     []*model.Client
+	NextPageURL string // URL of the next page of results, empty on the last page
 }
 
 func (c *ClientListCall) doRequest() (*http.Response, error) {
@@ -140,14 +213,18 @@ func (c *ClientListCall) doRequest() (*http.Response, error) {
 	if options.term != "" {
 		urlParams.Add("term", options.term)
 	}
+	options.addFilterParams(urlParams)
+	addPageParams(urlParams, c.page, options.perPage)
 	urls += "?" + urlParams.Encode()
+	if c.pageURL != "" {
+		urls = c.pageURL
+	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -162,11 +239,16 @@ func (c *ClientListCall) Do() (*ClientListResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	target := make([]*model.Client, 0)
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
 	}
 	ret := &ClientListResponse{}
 	ret.Clients = target
+	ret.NextPageURL = nextPageURL(res, len(target), c.Options().perPage)
 	return ret, nil
 }
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultUserAgent is sent with the API requests unless overridden with WithUserAgent
+const DefaultUserAgent = "glassfactory-go"
+
+// ClientOptions represent the options used when creating the HTTP client
+type ClientOptions struct {
+	endpoint   string            // API base URL
+	httpClient *http.Client      // HTTP client used as the base for the API client
+	transport  http.RoundTripper // Transport used for sending the requests
+	userAgent  string            // User-Agent header value
+	timeout    time.Duration     // HTTP client timeout
+}
+
+func (options *ClientOptions) apply(opts []ClientOption) {
+	for _, o := range opts {
+		o.apply(options)
+	}
+}
+
+// ClientOption overrides behavior of NewClient and NewService
+type ClientOption interface {
+	apply(*ClientOptions)
+}
+
+type clientOptionFunc func(*ClientOptions)
+
+func (f clientOptionFunc) apply(o *ClientOptions) {
+	f(o)
+}
+
+// WithEndpoint overrides the API base URL, including the Settings.BaseURL
+func WithEndpoint(url string) ClientOption {
+	return clientOptionFunc(func(o *ClientOptions) {
+		o.endpoint = url
+	})
+}
+
+// WithHTTPClient uses a copy of the given HTTP client for the API requests.
+// Authentication headers are added on top of the client's transport.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return clientOptionFunc(func(o *ClientOptions) {
+		o.httpClient = client
+	})
+}
+
+// WithTransport sends the API requests using the given transport instead of
+// http.DefaultTransport. Authentication headers are added before the requests
+// are passed to the transport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return clientOptionFunc(func(o *ClientOptions) {
+		o.transport = transport
+	})
+}
+
+// WithUserAgent sets the User-Agent header sent with the API requests
+func WithUserAgent(userAgent string) ClientOption {
+	return clientOptionFunc(func(o *ClientOptions) {
+		o.userAgent = userAgent
+	})
+}
+
+// WithTimeout sets the time limit for the API requests, including retries
+func WithTimeout(timeout time.Duration) ClientOption {
+	return clientOptionFunc(func(o *ClientOptions) {
+		o.timeout = timeout
+	})
+}
+
+// NewClientOptions creates ClientOptions with default values
+func NewClientOptions(opts []ClientOption) *ClientOptions {
+	options := &ClientOptions{
+		userAgent: DefaultUserAgent,
+	}
+	options.apply(opts)
+	return options
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	options := NewClientOptions(nil)
+	assert.Equal(t, options.userAgent, DefaultUserAgent)
+	assert.Equal(t, options.endpoint, "")
+	assert.Equal(t, options.timeout, time.Duration(0))
+
+	options = NewClientOptions([]ClientOption{
+		WithEndpoint("https://staging.example.com/api/"),
+		WithUserAgent("test-agent/1.0"),
+		WithTimeout(10 * time.Second),
+	})
+	assert.Equal(t, options.endpoint, "https://staging.example.com/api/")
+	assert.Equal(t, options.userAgent, "test-agent/1.0")
+	assert.Equal(t, options.timeout, 10*time.Second)
+}
+
+func TestNewClient_WithEndpoint(t *testing.T) {
+	ctx := context.Background()
+	settings := newTestSettings()
+	settings.BaseURL = "https://base.example.com/api/v1"
+
+	_, endpoint, err := NewClient(ctx, settings)
+	assert.NilError(t, err)
+	assert.Equal(t, endpoint, "https://base.example.com/api/v1/")
+
+	_, endpoint, err = NewClient(ctx, settings, WithEndpoint("https://staging.example.com/api/v1"))
+	assert.NilError(t, err)
+	assert.Equal(t, endpoint, "https://staging.example.com/api/v1/")
+
+	_, _, err = NewClient(ctx, settings, WithEndpoint("staging"))
+	assert.Error(t, err, "invalid endpoint")
+}
+
+func TestNewClient_WithHTTPClient(t *testing.T) {
+	ctx := context.Background()
+	settings := newTestSettings()
+	settings.Retry = &RetryPolicy{MaxAttempts: 1}
+
+	var headers http.Header
+	base := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			headers = req.Header.Clone()
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		}),
+	}
+	client, _, err := NewClient(ctx, settings, WithHTTPClient(base), WithUserAgent("test-agent/1.0"))
+	assert.NilError(t, err)
+	assert.Equal(t, client.Timeout, 5*time.Second)
+	assert.Assert(t, client != base, "HTTP client should be copied")
+
+	res, err := client.Get("https://example.glassfactory.io/api/public/v1/dummy")
+	assert.NilError(t, err)
+	res.Body.Close()
+	assert.Equal(t, headers.Get("X-User-Email"), settings.UserEmail)
+	assert.Equal(t, headers.Get("X-User-Token"), settings.UserToken)
+	assert.Equal(t, headers.Get("X-Account-Subdomain"), settings.AccountSubdomain)
+	assert.Equal(t, headers.Get("User-Agent"), "test-agent/1.0")
+}
+
+func TestNewClient_WithTransport(t *testing.T) {
+	ctx := context.Background()
+	settings := newTestSettings()
+	settings.Retry = &RetryPolicy{MaxAttempts: 1}
+
+	calls := 0
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		assert.Equal(t, req.Header.Get("X-User-Token"), settings.UserToken)
+		assert.Equal(t, req.Header.Get("User-Agent"), DefaultUserAgent)
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	})
+	client, _, err := NewClient(ctx, settings, WithTransport(transport), WithTimeout(time.Minute))
+	assert.NilError(t, err)
+	assert.Equal(t, client.Timeout, time.Minute)
+
+	res, err := client.Get("https://example.glassfactory.io/api/public/v1/dummy")
+	assert.NilError(t, err)
+	res.Body.Close()
+	assert.Equal(t, calls, 1)
+}
+
+func TestNewService_WithUserAgent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/clients.json").
+		MatchHeader("User-Agent", "test-agent/1.0").
+		Reply(200).
+		BodyString(`[]`)
+
+	s, err := NewService(context.Background(), newTestSettings(), WithUserAgent("test-agent/1.0"))
+	assert.NilError(t, err)
+	_, err = s.Client.All()
+	assert.NilError(t, err)
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/101loops/clock"
+)
+
+// redacted replaces the values of sensitive headers in the debug log
+const redacted = "REDACTED"
+
+// sensitiveHeaders are redacted from the debug log
+var sensitiveHeaders = map[string]bool{
+	"X-User-Token":  true,
+	"Authorization": true,
+}
+
+// DebugEntry is a debug log entry for a single API request
+type DebugEntry struct {
+	Time     time.Time         `json:"time"`
+	Method   string            `json:"method"`
+	URL      string            `json:"url"`
+	Header   map[string]string `json:"header,omitempty"`
+	Status   int               `json:"status,omitempty"`
+	Duration time.Duration     `json:"duration_ns"`
+	Size     int64             `json:"size"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// DebugTransport logs the API requests and responses
+type DebugTransport struct {
+	Base http.RoundTripper // Transport used for the requests, defaults to http.DefaultTransport
+	Out  io.Writer         // Writer for the log entries
+	JSON bool              // Write the entries as JSON lines instead of text
+
+	mu    sync.Mutex
+	clock clock.Clock
+}
+
+// NewDebugTransport creates a new DebugTransport
+func NewDebugTransport(base http.RoundTripper, out io.Writer, json bool) *DebugTransport {
+	return &DebugTransport{
+		Base:  base,
+		Out:   out,
+		JSON:  json,
+		clock: clock.New(),
+	}
+}
+
+func (t *DebugTransport) now() time.Time {
+	if t.clock == nil {
+		return time.Now()
+	}
+	return t.clock.Now()
+}
+
+func (t *DebugTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip executes the request and logs the response status, duration and size
+func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := &DebugEntry{
+		Time:   t.now(),
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: redactHeader(req.Header),
+	}
+	res, err := t.base().RoundTrip(req)
+	if err == nil {
+		entry.Status = res.StatusCode
+		// Read the body to log the response size and the full duration
+		var data []byte
+		data, err = ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			res = nil
+		} else {
+			res.Body = ioutil.NopCloser(bytes.NewReader(data))
+			entry.Size = int64(len(data))
+		}
+	}
+	entry.Duration = t.now().Sub(entry.Time)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	t.write(entry)
+	return res, err
+}
+
+func (t *DebugTransport) write(entry *DebugEntry) {
+	var buf bytes.Buffer
+	if t.JSON {
+		// Encoding a DebugEntry can't fail
+		_ = json.NewEncoder(&buf).Encode(entry)
+	} else {
+		writeDebugText(&buf, entry)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Out.Write(buf.Bytes())
+}
+
+func writeDebugText(w io.Writer, entry *DebugEntry) {
+	fmt.Fprintf(w, "--> %s %s\n", entry.Method, entry.URL)
+	keys := make([]string, 0, len(entry.Header))
+	for k := range entry.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "    %s: %s\n", k, entry.Header[k])
+	}
+	duration := entry.Duration.Round(time.Millisecond)
+	if entry.Error != "" {
+		fmt.Fprintf(w, "<-- %s %s (%s): %s\n", entry.Method, entry.URL, duration, entry.Error)
+		return
+	}
+	fmt.Fprintf(w, "<-- %d %s %s (%s, %d bytes)\n", entry.Status, entry.Method, entry.URL, duration, entry.Size)
+}
+
+// redactHeader returns the header values with sensitive values redacted
+func redactHeader(header http.Header) map[string]string {
+	values := make(map[string]string, len(header))
+	for k, v := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+			values[k] = redacted
+			continue
+		}
+		values[k] = strings.Join(v, ", ")
+	}
+	return values
+}
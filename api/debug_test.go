@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestDebugTransport(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/clients/1.json").
+		Reply(200).
+		BodyString(`{"id": 1, "name": "ACME"}`)
+
+	var out bytes.Buffer
+	settings := newTestSettings()
+	settings.DebugLog = &out
+	s, err := NewService(context.Background(), settings)
+	assert.NilError(t, err)
+
+	client, err := s.Client.Get(1)
+	assert.NilError(t, err)
+	assert.Equal(t, client.Name, "ACME")
+
+	log := out.String()
+	assert.Assert(t, strings.Contains(log, "--> GET https://example.glassfactory.io/api/public/v1/clients/1.json\n"), log)
+	assert.Assert(t, strings.Contains(log, "<-- 200 GET https://example.glassfactory.io/api/public/v1/clients/1.json"), log)
+	assert.Assert(t, strings.Contains(log, "25 bytes"), log)
+	assert.Assert(t, strings.Contains(log, "X-User-Token: REDACTED"), log)
+	assert.Assert(t, !strings.Contains(log, settings.UserToken), log)
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestDebugTransport_JSON(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/clients/1.json").
+		Reply(404).
+		BodyString(`{"error": "Not found"}`)
+
+	var out bytes.Buffer
+	settings := newTestSettings()
+	settings.DebugLog = &out
+	settings.DebugJSON = true
+	s, err := NewService(context.Background(), settings)
+	assert.NilError(t, err)
+
+	_, err = s.Client.Get(1)
+	assert.Assert(t, IsNotFound(err))
+
+	var entry DebugEntry
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, entry.Method, "GET")
+	assert.Equal(t, entry.URL, "https://example.glassfactory.io/api/public/v1/clients/1.json")
+	assert.Equal(t, entry.Status, 404)
+	assert.Equal(t, entry.Size, int64(22))
+	assert.Equal(t, entry.Header["X-User-Token"], redacted)
+	assert.Equal(t, entry.Header["X-User-Email"], settings.UserEmail)
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+// errorBody fails reads and records whether it has been closed
+type errorBody struct {
+	closed bool
+}
+
+func (b *errorBody) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (b *errorBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestDebugTransport_BodyError(t *testing.T) {
+	body := &errorBody{}
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		res := newStatusResponse(http.StatusOK, nil)
+		res.Body = body
+		return res, nil
+	})
+	var out bytes.Buffer
+	trans := NewDebugTransport(base, &out, true)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.glassfactory.io/api/public/v1/clients.json", nil)
+	assert.NilError(t, err)
+	res, err := trans.RoundTrip(req)
+	assert.ErrorContains(t, err, "connection reset")
+	assert.Assert(t, res == nil)
+	assert.Assert(t, body.closed)
+
+	var entry DebugEntry
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, entry.Error, "connection reset")
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxErrorBodySize limits how much of the error response body is read
+const maxErrorBodySize = 64 * 1024
+
+// Error contains an error response from Glass Factory
+type Error struct {
+	StatusCode int           // HTTP response status code
+	Method     string        // HTTP request method
+	URL        string        // Request URL
+	Message    string        // Error message decoded from the response body, if any
+	Body       string        // Raw response body
+	Header     http.Header   // Response headers
+	RetryAfter time.Duration // Value of the Retry-After header, zero if not set
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		return fmt.Sprintf("glassfactory: %s %s: %s: %s", e.Method, e.URL, status, e.Message)
+	}
+	return fmt.Sprintf("glassfactory: %s %s: %s", e.Method, e.URL, status)
+}
+
+// errorResponse represents the JSON error body returned by Glass Factory
+type errorResponse struct {
+	Error   string   `json:"error"`
+	Message string   `json:"message"`
+	Errors  []string `json:"errors"`
+}
+
+func (r *errorResponse) message() string {
+	switch {
+	case r.Message != "":
+		return r.Message
+	case r.Error != "":
+		return r.Error
+	default:
+		return strings.Join(r.Errors, ", ")
+	}
+}
+
+// CheckResponse returns an *Error if the response status code is not 2xx
+func CheckResponse(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+	e := &Error{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		if res.Request.URL != nil {
+			e.URL = res.Request.URL.String()
+		}
+	}
+	if res.Body != nil {
+		body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		if err == nil {
+			e.Body = string(body)
+			var target errorResponse
+			if err := json.Unmarshal(body, &target); err == nil {
+				e.Message = target.message()
+			}
+		}
+	}
+	return e
+}
+
+// parseRetryAfter parses the Retry-After header value given either in seconds
+// or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// hasStatus reports whether err is an *Error with the given status code
+func hasStatus(err error, code int) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode == code
+	}
+	return false
+}
+
+// IsNotFound returns true if the error was caused by a 404 Not Found response
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized returns true if the error was caused by a 401 Unauthorized response
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden returns true if the error was caused by a 403 Forbidden response
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited returns true if the error was caused by a 429 Too Many Requests response
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsServerError returns true if the error was caused by a 5xx response
+func IsServerError(err error) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	}
+	return false
+}
+
+// ValidationError is returned when a request is rejected before it is sent to Glass Factory
+type ValidationError struct {
+	Field   string // Name of the invalid field
+	Message string // Description of the problem
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("glassfactory: invalid %s: %s", e.Field, e.Message)
+}
+
+// IsValidationError returns true if the request was rejected by the client side
+// validation or by Glass Factory with a 422 Unprocessable Entity response
+func IsValidationError(err error) bool {
+	var e *ValidationError
+	if errors.As(err, &e) {
+		return true
+	}
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestCheckResponse(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+	rs := NewClientService(s)
+
+	t.Run("not found", func(t *testing.T) {
+		gock.New(domain).
+			Get(apiPath + "clients/1234.json").
+			Reply(404).
+			BodyString(`{"error": "Record not found"}`)
+
+		_, err := rs.Details(1234).Do()
+		assert.Assert(t, IsNotFound(err))
+		assert.Assert(t, !IsUnauthorized(err))
+
+		var apiErr *Error
+		assert.Assert(t, errors.As(err, &apiErr))
+		assert.Equal(t, apiErr.StatusCode, http.StatusNotFound)
+		assert.Equal(t, apiErr.Method, http.MethodGet)
+		assert.Equal(t, apiErr.URL, endpoint+"clients/1234.json")
+		assert.Equal(t, apiErr.Message, "Record not found")
+		assert.Error(t, err, fmt.Sprintf("glassfactory: GET %sclients/1234.json: 404 Not Found: Record not found", endpoint))
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		gock.New(domain).
+			Get(apiPath + "clients.json").
+			Reply(401).
+			BodyString(`You need to sign in before continuing.`)
+
+		_, err := rs.List().Do()
+		assert.Assert(t, IsUnauthorized(err))
+
+		var apiErr *Error
+		assert.Assert(t, errors.As(err, &apiErr))
+		assert.Equal(t, apiErr.Message, "")
+		assert.Equal(t, apiErr.Body, "You need to sign in before continuing.")
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		gock.New(domain).
+			Get(apiPath + "clients.json").
+			Reply(429).
+			SetHeader("Retry-After", "30").
+			BodyString(`{"errors": ["Rate limit exceeded"]}`)
+
+		_, err := rs.List().Do()
+		assert.Assert(t, IsRateLimited(err))
+		assert.Assert(t, !IsServerError(err))
+
+		var apiErr *Error
+		assert.Assert(t, errors.As(err, &apiErr))
+		assert.Equal(t, apiErr.RetryAfter, 30*time.Second)
+		assert.Equal(t, apiErr.Message, "Rate limit exceeded")
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		gock.New(domain).
+			Get(apiPath + "clients.json").
+			Reply(502)
+
+		_, err := rs.List().Do()
+		assert.Assert(t, IsServerError(err))
+	})
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, time.October, 1, 12, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{
+			name:     "empty",
+			value:    "",
+			expected: 0,
+		},
+		{
+			name:     "seconds",
+			value:    "120",
+			expected: 2 * time.Minute,
+		},
+		{
+			name:     "negative seconds",
+			value:    "-1",
+			expected: 0,
+		},
+		{
+			name:     "http date",
+			value:    "Tue, 01 Oct 2019 12:00:30 GMT",
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "http date in the past",
+			value:    "Tue, 01 Oct 2019 11:00:00 GMT",
+			expected: 0,
+		},
+		{
+			name:     "invalid",
+			value:    "soon",
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, parseRetryAfter(tt.value, now), tt.expected)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -37,26 +38,46 @@ func (r *MemberService) GetActive(opts ...RequestOption) ([]*model.Member, error
 
 // Active returns all active members in the Glass Factory account
 func (r *MemberService) Active(opts ...RequestOption) ([]*model.Member, error) {
+	return r.ActiveContext(r.s.defaultContext(), opts...)
+}
+
+// ActiveContext returns all active members in the Glass Factory account using the given context
+func (r *MemberService) ActiveContext(ctx context.Context, opts ...RequestOption) ([]*model.Member, error) {
 	opts = append(opts, WithStatus(memberStatusActive))
-	res, err := r.List(opts...).Do()
-	if err != nil {
-		return nil, err
-	}
-	return res.Members, nil
+	return r.listAll(ctx, opts...)
 }
 
 // All returns all members in the Glass Factory account
 func (r *MemberService) All(opts ...RequestOption) ([]*model.Member, error) {
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all members in the Glass Factory account using the given context
+func (r *MemberService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Member, error) {
 	opts = append(opts, WithStatus(memberStatusAll))
-	res, err := r.List(opts...).Do()
+	return r.listAll(ctx, opts...)
+}
+
+// listAll returns members from all pages of the list results
+func (r *MemberService) listAll(ctx context.Context, opts ...RequestOption) ([]*model.Member, error) {
+	members := make([]*model.Member, 0)
+	err := r.List(opts...).Pages(ctx, func(res *MemberListResponse) error {
+		members = append(members, res.Members...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return res.Members, nil
+	return members, nil
 }
 
 // Get returns a member from Glass Factory by their user ID
 func (r *MemberService) Get(userID int, opts ...RequestOption) (*model.Member, error) {
+	return r.GetContext(r.s.defaultContext(), userID, opts...)
+}
+
+// GetContext returns a member from Glass Factory by their user ID using the given context
+func (r *MemberService) GetContext(ctx context.Context, userID int, opts ...RequestOption) (*model.Member, error) {
 	options := NewRequestOptions(opts)
 	if options.cache {
 		member, ok := r.members.Get(userID)
@@ -64,7 +85,7 @@ func (r *MemberService) Get(userID int, opts ...RequestOption) (*model.Member, e
 			return member, nil
 		}
 	}
-	res, err := r.Details(userID).Do()
+	res, err := r.Details(userID).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -86,14 +107,14 @@ func (r *MemberService) Details(userID int) *MemberDetailsCall {
 //
 // Deprecated: Filter members outside the service.
 func (r *MemberService) FindByEmail(email string, opts ...RequestOption) (*model.Member, error) {
-	res, err := r.List(opts...).Do()
+	members, err := r.listAll(r.s.defaultContext(), opts...)
 	if err != nil {
 		return nil, err
 	}
-	if len(res.Members) == 0 {
+	if len(members) == 0 {
 		return nil, errors.New("no users found")
 	}
-	for _, member := range res.Members {
+	for _, member := range members {
 		if member.Email == email {
 			return member, nil
 		}
@@ -133,9 +154,16 @@ func (r *MemberService) ListArchived() *MemberListCall {
 // MemberDetailsCall represents a request to Member Details API
 type MemberDetailsCall struct {
 	s      *Service
+	ctx    context.Context
 	userID int
 }
 
+// Context sets the context to be used in this call's Do method
+func (c *MemberDetailsCall) Context(ctx context.Context) *MemberDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
 // MemberDetailsResponse represents a response from Member Details API
 type MemberDetailsResponse struct {
 	Member *model.Member
@@ -149,12 +177,11 @@ func (c *MemberDetailsCall) doRequest() (*http.Response, error) {
 		return nil, errors.New("client ID is required")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -169,6 +196,10 @@ func (c *MemberDetailsCall) Do() (*MemberDetailsResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	var target model.Member
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
@@ -181,7 +212,54 @@ func (c *MemberDetailsCall) Do() (*MemberDetailsResponse, error) {
 // MemberListCall represents a request to List Staff Members API
 type MemberListCall struct {
 	s       *Service
+	ctx     context.Context
 	options []RequestOption
+	page    int
+	pageURL string
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *MemberListCall) Context(ctx context.Context) *MemberListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Page sets the page of results to return in this call's Do method
+func (c *MemberListCall) Page(page int) *MemberListCall {
+	c.page = page
+	c.pageURL = ""
+	return c
+}
+
+// Pages fetches all pages of results, calling f for each page
+func (c *MemberListCall) Pages(ctx context.Context, f func(*MemberListResponse) error) error {
+	c.ctx = ctx
+	defer func(pageURL string) { c.pageURL = pageURL }(c.pageURL)
+	// Stop if the server links to a page that has already been fetched
+	fetched := make(map[string]bool)
+	var prev []int
+	for {
+		res, err := c.Do()
+		if err != nil {
+			return err
+		}
+		ids := make([]int, len(res.Members))
+		for i, item := range res.Members {
+			ids[i] = item.ID
+		}
+		if prev != nil && samePage(ids, prev) {
+			return nil
+		}
+		prev = ids
+		if err := f(res); err != nil {
+			return err
+		}
+		if res.NextPageURL == "" || fetched[res.NextPageURL] {
+			return nil
+		}
+		fetched[res.NextPageURL] = true
+		c.pageURL = res.NextPageURL
+	}
 }
 
 // Options returns request options with defaults
@@ -195,8 +273,9 @@ func (c *MemberListCall) Options() RequestOptions {
 
 // MemberListResponse represents a response from List Staff Members API
 type MemberListResponse struct {
-	Members []*model.Member
-	Status  string
+	Members     []*model.Member
+	Status      string
+	NextPageURL string // URL of the next page of results, empty on the last page
 }
 
 func (c *MemberListCall) doRequest() (*http.Response, error) {
@@ -216,14 +295,18 @@ func (c *MemberListCall) doRequest() (*http.Response, error) {
 	if options.term != "" {
 		urlParams.Add("term", options.term)
 	}
+	options.addFilterParams(urlParams)
+	addPageParams(urlParams, c.page, options.perPage)
 	urls += "?" + urlParams.Encode()
+	if c.pageURL != "" {
+		urls = c.pageURL
+	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -238,12 +321,17 @@ func (c *MemberListCall) Do() (*MemberListResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	target := make([]*model.Member, 0)
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
 	}
 	ret := &MemberListResponse{}
 	ret.Members = target
+	ret.NextPageURL = nextPageURL(res, len(target), c.Options().perPage)
 	//ret.Status = c.status
 	return ret, nil
 }
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// NewOfficeService initialises a new OfficeService
+func NewOfficeService(s *Service) *OfficeService {
+	rs := &OfficeService{s: s}
+	rs.offices = model.NewOfficeCollection()
+	return rs
+}
+
+// OfficeService is used for calling the Glass Factory office APIs
+type OfficeService struct {
+	s       *Service
+	offices *model.OfficeCollection
+}
+
+// All returns all offices in the Glass Factory account
+func (r *OfficeService) All(opts ...RequestOption) ([]*model.Office, error) {
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all offices in the Glass Factory account using the given context
+func (r *OfficeService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Office, error) {
+	res, err := r.List(opts...).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return res.Offices, nil
+}
+
+// Get returns an office from Glass Factory
+func (r *OfficeService) Get(officeID int, opts ...RequestOption) (*model.Office, error) {
+	return r.GetContext(r.s.defaultContext(), officeID, opts...)
+}
+
+// GetContext returns an office from Glass Factory using the given context
+func (r *OfficeService) GetContext(ctx context.Context, officeID int, opts ...RequestOption) (*model.Office, error) {
+	options := NewRequestOptions(opts)
+	if options.cache {
+		office, ok := r.offices.Get(officeID)
+		if ok {
+			return office, nil
+		}
+	}
+	res, err := r.Details(officeID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if options.cache {
+		r.offices.Add(res.Office)
+	}
+	return res.Office, nil
+}
+
+// Details returns office details from Glass Factory
+func (r *OfficeService) Details(officeID int) *OfficeDetailsCall {
+	c := &OfficeDetailsCall{s: r.s}
+	c.officeID = officeID
+	return c
+}
+
+// List returns a list of offices in the Glass Factory account
+func (r *OfficeService) List(opts ...RequestOption) *OfficeListCall {
+	c := &OfficeListCall{s: r.s}
+	c.options = opts
+	return c
+}
+
+// OfficeDetailsCall represents a request to Office Details API
+type OfficeDetailsCall struct {
+	s        *Service
+	ctx      context.Context
+	officeID int
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *OfficeDetailsCall) Context(ctx context.Context) *OfficeDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
+// OfficeDetailsResponse represents a response from Office Details API
+type OfficeDetailsResponse struct {
+	Office *model.Office
+}
+
+func (c *OfficeDetailsCall) doRequest() (*http.Response, error) {
+	var urls string
+	if c.officeID > 0 {
+		urls = c.s.BasePath + fmt.Sprintf("offices/%d.json", c.officeID)
+	} else {
+		return nil, errors.New("office ID is required")
+	}
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *OfficeDetailsCall) Do() (*OfficeDetailsResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	var target model.Office
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &OfficeDetailsResponse{}
+	ret.Office = &target
+	return ret, nil
+}
+
+// OfficeListCall represents a request to List Account's Offices API
+type OfficeListCall struct {
+	s       *Service
+	ctx     context.Context
+	options []RequestOption
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *OfficeListCall) Context(ctx context.Context) *OfficeListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Options returns request options with defaults
+func (c *OfficeListCall) Options() RequestOptions {
+	options := RequestOptions{}
+	options.apply(c.options)
+	return options
+}
+
+// OfficeListResponse represents a response from List Account's Offices API
+type OfficeListResponse struct {
+	Offices []*model.Office
+}
+
+func (c *OfficeListCall) doRequest() (*http.Response, error) {
+	var urls string
+	urls = c.s.BasePath + "offices.json"
+
+	options := c.Options()
+
+	urlParams := url.Values{}
+	if options.term != "" {
+		urlParams.Add("term", options.term)
+	}
+	urls += "?" + urlParams.Encode()
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *OfficeListCall) Do() (*OfficeListResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	target := make([]*model.Office, 0)
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &OfficeListResponse{}
+	ret.Offices = target
+	return ret, nil
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/markosamuli/glassfactory/model"
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestGetOffice(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+	officeID := 789
+
+	gock.New(domain).
+		Get(apiPath + fmt.Sprintf("offices/%d.json", officeID)).
+		Reply(200).
+		BodyString(`{
+			"id": 789,
+			"name": "London"
+		}`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewOfficeService(s)
+
+	office, err := rs.Get(officeID)
+	assert.NilError(t, err)
+	assert.Equal(t, office.ID, officeID)
+	assert.Equal(t, office.Name, "London")
+
+	// Second call is served from the cache
+	cached, err := rs.Get(officeID)
+	assert.NilError(t, err)
+	assert.Equal(t, cached, office)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestListOffices(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+
+	gock.New(domain).
+		Get(apiPath + "offices.json").
+		Reply(200).
+		BodyString(`[
+			{"id": 789, "name": "London"},
+			{"id": 790, "name": "New York"}
+		]`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewOfficeService(s)
+
+	offices, err := rs.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(offices), 2)
+	assert.Equal(t, offices[1].Name, "New York")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestHydrateTimeReports_Offices(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+
+	gock.New(domain).
+		Get(apiPath + "clients/1.json").
+		Reply(200).
+		BodyString(`{"id": 1, "name": "ACME Inc.", "office_id": 789}`)
+	gock.New(domain).
+		Get(apiPath + "projects/10.json").
+		Reply(200).
+		BodyString(`{"id": 10, "name": "Website", "client_id": 1, "office_id": 790}`)
+	gock.New(domain).
+		Get(apiPath + "projects/11.json").
+		Reply(200).
+		BodyString(`{"id": 11, "name": "Internal", "client_id": 1}`)
+	gock.New(domain).
+		Get(apiPath + "offices/790.json").
+		Reply(200).
+		BodyString(`{"id": 790, "name": "New York"}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	// Cached offices are not fetched again
+	s.Office.offices.Add(&model.Office{ID: 789, Name: "London"})
+
+	reports := []*model.MemberTimeReport{
+		{ClientID: 1, ProjectID: 10},
+		{ClientID: 1, ProjectID: 11},
+	}
+	err = s.HydrateTimeReports(context.Background(), reports)
+	assert.NilError(t, err)
+
+	assert.Equal(t, reports[0].Office.Name, "New York")
+	assert.Equal(t, reports[1].Office.Name, "London")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
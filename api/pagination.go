@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// paginationHeaders are the response headers used for following paginated results
+var paginationHeaders = []string{"Link", "X-Next-Page"}
+
+// addPageParams adds the page and per_page query parameters when set
+func addPageParams(urlParams url.Values, page int, perPage int) {
+	if page > 0 {
+		urlParams.Set("page", strconv.Itoa(page))
+	}
+	if perPage > 0 {
+		urlParams.Set("per_page", strconv.Itoa(perPage))
+	}
+}
+
+// nextPageURL returns the URL of the page following the response or an empty
+// string if the response is the last page.
+//
+// The next page is resolved from the Link header, the X-Next-Page header or, when
+// the page size was requested and the response has neither header, by incrementing
+// the page parameter if the page was full. Links to another scheme or host are
+// ignored to avoid sending the credentials there.
+func nextPageURL(res *http.Response, count int, perPage int) string {
+	if count == 0 || res.Request == nil {
+		return ""
+	}
+	reqURL := res.Request.URL
+	if header := res.Header.Get("Link"); header != "" {
+		link := parseLinkHeader(header)["next"]
+		next, err := url.Parse(link)
+		if link == "" || err != nil {
+			return ""
+		}
+		next = reqURL.ResolveReference(next)
+		if next.Scheme != reqURL.Scheme || next.Host != reqURL.Host {
+			return ""
+		}
+		return next.String()
+	}
+	query := reqURL.Query()
+	if page := res.Header.Get("X-Next-Page"); page != "" {
+		query.Set("page", page)
+	} else if perPage > 0 && count == perPage {
+		page, _ := strconv.Atoi(query.Get("page"))
+		if page < 1 {
+			page = 1
+		}
+		query.Set("page", strconv.Itoa(page+1))
+	} else {
+		return ""
+	}
+	next := *reqURL
+	next.RawQuery = query.Encode()
+	return next.String()
+}
+
+// samePage reports whether the pages contain the same item IDs. Servers ignoring the
+// paging parameters return the first page again.
+func samePage(ids []int, prev []int) bool {
+	if len(ids) != len(prev) {
+		return false
+	}
+	for i := range ids {
+		if ids[i] != prev[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLinkHeader returns the URLs in a RFC 8288 Link header by their relation type
+func parseLinkHeader(header string) map[string]string {
+	links := make(map[string]string)
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		target = strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+		for _, param := range parts[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "rel" {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+				links[rel] = target
+			}
+		}
+	}
+	return links
+}
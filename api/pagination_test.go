@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	links := parseLinkHeader(`<https://example.glassfactory.io/api/public/v1/projects.json?page=2>; rel="next", ` +
+		`</api/public/v1/projects.json?page=5>; rel="last"`)
+	assert.Equal(t, links["next"], "https://example.glassfactory.io/api/public/v1/projects.json?page=2")
+	assert.Equal(t, links["last"], "/api/public/v1/projects.json?page=5")
+	assert.Equal(t, len(parseLinkHeader("")), 0)
+}
+
+func TestProjectListCall_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects.json").
+		MatchParam("page", "2").
+		Reply(200).
+		BodyString(`[{"id": 3, "name": "Third"}]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects.json").
+		Reply(200).
+		SetHeader("Link", `</api/public/v1/projects.json?page=2>; rel="next"`).
+		BodyString(`[{"id": 1, "name": "First"}, {"id": 2, "name": "Second"}]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	pages := 0
+	err = s.Project.List().Pages(context.Background(), func(res *ProjectListResponse) error {
+		pages++
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, pages, 2)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestClientService_All_PageSize(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/clients.json").
+		MatchParam("per_page", "2").
+		MatchParam("page", "2").
+		Reply(200).
+		BodyString(`[{"id": 3, "name": "Third"}]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/clients.json").
+		MatchParam("per_page", "2").
+		Reply(200).
+		BodyString(`[{"id": 1, "name": "First"}, {"id": 2, "name": "Second"}]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	clients, err := s.Client.All(WithPageSize(2))
+	assert.NilError(t, err)
+	assert.Equal(t, len(clients), 3)
+	assert.Equal(t, clients[2].Name, "Third")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestMemberListCall_NextPageHeader(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/active.json").
+		MatchParam("page", "2").
+		Reply(200).
+		BodyString(`[{"id": 2, "name": "Second"}]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/active.json").
+		Times(2).
+		Reply(200).
+		SetHeader("X-Next-Page", "2").
+		BodyString(`[{"id": 1, "name": "First"}]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	res, err := s.Member.List(WithStatus("active")).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Members), 1)
+	assert.Equal(t, res.NextPageURL, "https://example.glassfactory.io/api/public/v1/members/active.json?page=2")
+
+	members, err := s.Member.Active()
+	assert.NilError(t, err)
+	assert.Equal(t, len(members), 2)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestProjectListCall_PagesError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects.json").
+		Reply(200).
+		SetHeader("Link", `</api/public/v1/projects.json?page=2>; rel="next"`).
+		BodyString(`[{"id": 1, "name": "First"}]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects.json").
+		MatchParam("page", "2").
+		Reply(http.StatusNotFound).
+		BodyString(`{"error": "Not found"}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	_, err = s.Project.All()
+	assert.Assert(t, IsNotFound(err))
+}
+
+func TestNextPageURL(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.glassfactory.io/api/public/v1/projects.json?per_page=2", nil)
+	assert.NilError(t, err)
+
+	var tests = []struct {
+		name     string
+		header   http.Header
+		count    int
+		perPage  int
+		expected string
+	}{
+		{
+			name:     "relative link",
+			header:   http.Header{"Link": {`</api/public/v1/projects.json?page=2>; rel="next"`}},
+			count:    2,
+			expected: "https://example.glassfactory.io/api/public/v1/projects.json?page=2",
+		},
+		{
+			name:     "absolute link",
+			header:   http.Header{"Link": {`<https://example.glassfactory.io/api/public/v1/projects.json?page=2>; rel="next"`}},
+			count:    2,
+			expected: "https://example.glassfactory.io/api/public/v1/projects.json?page=2",
+		},
+		{
+			name:   "link to another host",
+			header: http.Header{"Link": {`<https://evil.example.com/api/public/v1/projects.json?page=2>; rel="next"`}},
+			count:  2,
+		},
+		{
+			name:   "link to another scheme",
+			header: http.Header{"Link": {`<http://example.glassfactory.io/api/public/v1/projects.json?page=2>; rel="next"`}},
+			count:  2,
+		},
+		{
+			name:     "next page header",
+			header:   http.Header{"X-Next-Page": {"2"}},
+			count:    2,
+			expected: "https://example.glassfactory.io/api/public/v1/projects.json?page=2&per_page=2",
+		},
+		{
+			name:     "full page without headers",
+			header:   http.Header{},
+			count:    2,
+			perPage:  2,
+			expected: "https://example.glassfactory.io/api/public/v1/projects.json?page=2&per_page=2",
+		},
+		{
+			name:    "short page without headers",
+			header:  http.Header{},
+			count:   1,
+			perPage: 2,
+		},
+		{
+			name:    "empty page without headers",
+			header:  http.Header{},
+			count:   0,
+			perPage: 2,
+		},
+		{
+			name:   "page size not requested",
+			header: http.Header{},
+			count:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{Header: tt.header, Request: req}
+			assert.Equal(t, nextPageURL(res, tt.count, tt.perPage), tt.expected)
+		})
+	}
+}
+
+func TestProjectListCall_PagesIgnoredParams(t *testing.T) {
+	// Server ignoring the paging parameters and returning the first page again
+	var requests int
+	var header http.Header
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if requests > 10 {
+				return nil, fmt.Errorf("too many requests")
+			}
+			res := newHTTPResponseWithJSONBody(`[{"id": 1, "name": "First"}, {"id": 2, "name": "Second"}]`)
+			for key, values := range header {
+				res.Header[key] = values
+			}
+			res.Request = req
+			return res, nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+	s.Project = NewProjectService(s)
+
+	t.Run("without headers", func(t *testing.T) {
+		requests = 0
+		header = nil
+		projects, err := s.Project.All(WithPageSize(2))
+		assert.NilError(t, err)
+		assert.Equal(t, requests, 2)
+		assert.Equal(t, len(projects), 2)
+	})
+
+	t.Run("linking to the same page", func(t *testing.T) {
+		requests = 0
+		header = http.Header{"Link": {`</api/public/v1/projects.json?page=2&per_page=2>; rel="next"`}}
+		projects, err := s.Project.All(WithPageSize(2))
+		assert.NilError(t, err)
+		assert.Equal(t, requests, 2)
+		assert.Equal(t, len(projects), 2)
+	})
+}
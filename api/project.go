@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -24,15 +25,29 @@ type ProjectService struct {
 
 // All returns all projects in the Glass Factory account
 func (r *ProjectService) All(opts ...RequestOption) ([]*model.Project, error) {
-	res, err := r.List(opts...).Do()
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all projects in the Glass Factory account using the given context
+func (r *ProjectService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Project, error) {
+	projects := make([]*model.Project, 0)
+	err := r.List(opts...).Pages(ctx, func(res *ProjectListResponse) error {
+		projects = append(projects, res.Projects...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return res.Projects, nil
+	return projects, nil
 }
 
 // Get returns a project from Glass Factory
 func (r *ProjectService) Get(projectID int, opts ...RequestOption) (*model.Project, error) {
+	return r.GetContext(r.s.defaultContext(), projectID, opts...)
+}
+
+// GetContext returns a project from Glass Factory using the given context
+func (r *ProjectService) GetContext(ctx context.Context, projectID int, opts ...RequestOption) (*model.Project, error) {
 	options := NewRequestOptions(opts)
 	if options.cache {
 		project, ok := r.projects.Get(projectID)
@@ -40,7 +55,7 @@ func (r *ProjectService) Get(projectID int, opts ...RequestOption) (*model.Proje
 			return project, nil
 		}
 	}
-	res, err := r.Details(projectID).Do()
+	res, err := r.Details(projectID).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -67,9 +82,16 @@ func (r *ProjectService) List(opts ...RequestOption) *ProjectListCall {
 // ProjectDetailsCall represents a request to Project Details API
 type ProjectDetailsCall struct {
 	s         *Service
+	ctx       context.Context
 	projectID int
 }
 
+// Context sets the context to be used in this call's Do method
+func (c *ProjectDetailsCall) Context(ctx context.Context) *ProjectDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
 // ProjectDetailsResponse represents a response from Project Details API
 type ProjectDetailsResponse struct {
 	Project *model.Project
@@ -83,12 +105,11 @@ func (c *ProjectDetailsCall) doRequest() (*http.Response, error) {
 		return nil, errors.New("project ID is required")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -103,6 +124,10 @@ func (c *ProjectDetailsCall) Do() (*ProjectDetailsResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	var target model.Project
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
@@ -115,7 +140,54 @@ func (c *ProjectDetailsCall) Do() (*ProjectDetailsResponse, error) {
 // ProjectListCall represents a request to List Account's PRojects API
 type ProjectListCall struct {
 	s       *Service
+	ctx     context.Context
 	options []RequestOption
+	page    int
+	pageURL string
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *ProjectListCall) Context(ctx context.Context) *ProjectListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Page sets the page of results to return in this call's Do method
+func (c *ProjectListCall) Page(page int) *ProjectListCall {
+	c.page = page
+	c.pageURL = ""
+	return c
+}
+
+// Pages fetches all pages of results, calling f for each page
+func (c *ProjectListCall) Pages(ctx context.Context, f func(*ProjectListResponse) error) error {
+	c.ctx = ctx
+	defer func(pageURL string) { c.pageURL = pageURL }(c.pageURL)
+	// Stop if the server links to a page that has already been fetched
+	fetched := make(map[string]bool)
+	var prev []int
+	for {
+		res, err := c.Do()
+		if err != nil {
+			return err
+		}
+		ids := make([]int, len(res.Projects))
+		for i, item := range res.Projects {
+			ids[i] = item.ID
+		}
+		if prev != nil && samePage(ids, prev) {
+			return nil
+		}
+		prev = ids
+		if err := f(res); err != nil {
+			return err
+		}
+		if res.NextPageURL == "" || fetched[res.NextPageURL] {
+			return nil
+		}
+		fetched[res.NextPageURL] = true
+		c.pageURL = res.NextPageURL
+	}
 }
 
 // Options returns request options with defaults
@@ -127,7 +199,8 @@ func (c *ProjectListCall) Options() RequestOptions {
 
 // ProjectListResponse represents a response from List Account's PRojects API
 type ProjectListResponse struct {
-	Projects []*model.Project
+	Projects    []*model.Project
+	NextPageURL string // URL of the next page of results, empty on the last page
 }
 
 func (c *ProjectListCall) doRequest() (*http.Response, error) {
@@ -140,14 +213,18 @@ func (c *ProjectListCall) doRequest() (*http.Response, error) {
 	if options.term != "" {
 		urlParams.Add("term", options.term)
 	}
+	options.addFilterParams(urlParams)
+	addPageParams(urlParams, c.page, options.perPage)
 	urls += "?" + urlParams.Encode()
+	if c.pageURL != "" {
+		urls = c.pageURL
+	}
 
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -162,11 +239,16 @@ func (c *ProjectListCall) Do() (*ProjectListResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	target := make([]*model.Project, 0)
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
 	}
 	ret := &ProjectListResponse{}
 	ret.Projects = target
+	ret.NextPageURL = nextPageURL(res, len(target), c.Options().perPage)
 	return ret, nil
 }
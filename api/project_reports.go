@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// Reports returns time reports of all members between given dates.
+// At least one of the WithProject, WithClient or WithOffice options is required.
+func (r *ProjectService) Reports(start time.Time, end time.Time, opts ...TimeReportOption) ([]*model.MemberTimeReport, error) {
+	return r.ReportsContext(r.s.defaultContext(), start, end, opts...)
+}
+
+// ReportsContext returns time reports of all members between given dates using the
+// given context. The time reports of each member are fetched with the project, client
+// and office filters and merged in the order of the members.
+func (r *ProjectService) ReportsContext(ctx context.Context, start time.Time, end time.Time, opts ...TimeReportOption) ([]*model.MemberTimeReport, error) {
+	options := NewTimeReportOptions(opts)
+	if len(options.projectIDs) == 0 && options.clientID == 0 && options.officeID == 0 {
+		return nil, errors.New("project, client or office is required")
+	}
+
+	members, err := r.s.Member.AllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*model.MemberTimeReport, 0)
+	for _, member := range members {
+		calls := r.s.Member.Reports.TimeReportsBetweenDates(member.ID, start, end, opts...)
+		responses, err := calls.Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, response := range responses {
+			for _, report := range response.Reports {
+				report.UserID = member.ID
+				reports = append(reports, report)
+			}
+		}
+	}
+
+	// Fetch related data if FetchRelated() option was enabled
+	if options.fetchRelated {
+		if err := r.s.HydrateTimeReports(ctx, reports, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return reports, nil
+}
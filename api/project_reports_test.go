@@ -0,0 +1,103 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestProjectReports(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+	projectID := 222
+
+	gock.New(domain).
+		Get(apiPath + "members.json").
+		Reply(200).
+		BodyString(`[{"id": 123, "name": "First"}, {"id": 456, "name": "Second"}]`)
+
+	gock.New(domain).
+		Get(apiPath+"members/123/reports/time.json").
+		MatchParam("start", "2019-08-15").
+		MatchParam("end", "2019-08-31").
+		MatchParam("project_id", fmt.Sprintf("%d", projectID)).
+		Reply(200).
+		BodyString(`[
+		  {"project_id": 222, "date": "2019-08-15", "planned": 8, "time": 7.5}
+		]`)
+
+	gock.New(domain).
+		Get(apiPath+"members/123/reports/time.json").
+		MatchParam("start", "2019-09-01").
+		MatchParam("end", "2019-09-15").
+		MatchParam("project_id", fmt.Sprintf("%d", projectID)).
+		Reply(200).
+		BodyString(`[
+		  {"project_id": 222, "date": "2019-09-02", "planned": 8, "time": 8}
+		]`)
+
+	gock.New(domain).
+		Get(apiPath+"members/456/reports/time.json").
+		MatchParam("start", "2019-08-15").
+		MatchParam("end", "2019-08-31").
+		MatchParam("project_id", fmt.Sprintf("%d", projectID)).
+		Reply(200).
+		BodyString(`[]`)
+
+	gock.New(domain).
+		Get(apiPath+"members/456/reports/time.json").
+		MatchParam("start", "2019-09-01").
+		MatchParam("end", "2019-09-15").
+		MatchParam("project_id", fmt.Sprintf("%d", projectID)).
+		Reply(200).
+		BodyString(`[
+		  {"project_id": 222, "date": "2019-09-02", "planned": 4, "time": 3}
+		]`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+	s.Member = NewMemberService(s)
+	s.Project = NewProjectService(s)
+
+	start := time.Date(2019, time.August, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 15, 0, 0, 0, 0, time.UTC)
+
+	reports, err := s.Project.Reports(start, end, WithProject(projectID))
+	assert.NilError(t, err)
+	assert.Equal(t, len(reports), 3)
+	assert.Equal(t, reports[0].UserID, 123)
+	assert.Equal(t, reports[1].UserID, 123)
+	assert.Equal(t, reports[2].UserID, 456)
+	for _, r := range reports {
+		assert.Equal(t, r.ProjectID, projectID)
+	}
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestProjectReportsFilterRequired(t *testing.T) {
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request to %s", req.URL)
+			return nil, nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+	s.Member = NewMemberService(s)
+	s.Project = NewProjectService(s)
+
+	start := time.Date(2019, time.September, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 15, 0, 0, 0, 0, time.UTC)
+	_, err := s.Project.Reports(start, end)
+	assert.Error(t, err, "project, client or office is required")
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/101loops/clock"
+)
+
+// RateLimiter is a token bucket limiting how often requests are sent
+type RateLimiter struct {
+	mu     sync.Mutex
+	clock  clock.Clock
+	rate   float64 // Tokens added per second
+	burst  float64 // Maximum number of tokens in the bucket
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter allowing rate requests per second
+// with bursts of up to burst requests
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	l := &RateLimiter{
+		clock:  clock.New(),
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+	l.last = l.clock.Now()
+	return l
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// needs to wait before the token can be used
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request can be sent or the request context is done
+func (l *RateLimiter) Wait(req *http.Request) error {
+	return sleepContext(req.Context(), l.reserve())
+}
+
+// RateLimitTransport delays requests to stay within the limits of the RateLimiter
+type RateLimitTransport struct {
+	Base    http.RoundTripper // Transport used for the requests, defaults to http.DefaultTransport
+	Limiter *RateLimiter
+}
+
+// RoundTrip waits for the rate limiter and executes the request
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Limiter != nil {
+		if err := t.Limiter.Wait(req); err != nil {
+			return nil, err
+		}
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
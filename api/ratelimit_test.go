@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/101loops/clock"
+	"gotest.tools/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	mock := clock.NewMock().FreezeAt(time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC))
+
+	l := NewRateLimiter(2, 2)
+	l.clock = mock
+	l.last = mock.Now()
+
+	// Burst of two requests is allowed without waiting
+	assert.Equal(t, l.reserve(), time.Duration(0))
+	assert.Equal(t, l.reserve(), time.Duration(0))
+
+	// Following requests wait for new tokens
+	assert.Equal(t, l.reserve(), 500*time.Millisecond)
+	assert.Equal(t, l.reserve(), time.Second)
+
+	// Tokens are refilled over time up to the burst size
+	mock.Add(10 * time.Second)
+	assert.Equal(t, l.reserve(), time.Duration(0))
+	assert.Equal(t, l.reserve(), time.Duration(0))
+	assert.Equal(t, l.reserve(), 500*time.Millisecond)
+}
+
+func TestNewClient_Transports(t *testing.T) {
+	var tests = []struct {
+		name      string
+		retry     *RetryPolicy
+		rateLimit float64
+		check     func(t *testing.T, trans http.RoundTripper)
+	}{
+		{
+			name: "default retry policy",
+			check: func(t *testing.T, trans http.RoundTripper) {
+				rt, ok := trans.(*RetryTransport)
+				assert.Assert(t, ok)
+				assert.Equal(t, rt.Policy, *DefaultRetryPolicy())
+				_, ok = rt.Base.(*AuthTransport)
+				assert.Assert(t, ok)
+			},
+		},
+		{
+			name:  "retries disabled",
+			retry: &RetryPolicy{MaxAttempts: 1},
+			check: func(t *testing.T, trans http.RoundTripper) {
+				_, ok := trans.(*AuthTransport)
+				assert.Assert(t, ok)
+			},
+		},
+		{
+			name:      "rate limited",
+			retry:     &RetryPolicy{MaxAttempts: 1},
+			rateLimit: 5,
+			check: func(t *testing.T, trans http.RoundTripper) {
+				rl, ok := trans.(*RateLimitTransport)
+				assert.Assert(t, ok)
+				assert.Equal(t, rl.Limiter.rate, 5.0)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := newTestSettings()
+			settings.Retry = tt.retry
+			settings.RateLimit = tt.rateLimit
+			client, _, err := NewClient(context.Background(), settings)
+			assert.NilError(t, err)
+			tt.check(t, client.Transport)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// HydrateTimeReports populates the related clients, projects, offices, roles and
+// activities of the time reports.
+//
+// Distinct client and project IDs are collected first and each of them is fetched only
+// once. Clients and projects already in the service cache are not fetched again. Offices
+// are resolved from the project office, or the client office if the project has none.
+// Offices, roles and activities the member can't access are left nil. Use
+// WithConcurrency() to fetch the missing details in parallel.
+func (s *Service) HydrateTimeReports(ctx context.Context, reports []*model.MemberTimeReport, opts ...TimeReportOption) error {
+	if ctx == nil {
+		ctx = s.defaultContext()
+	}
+	options := NewTimeReportOptions(opts)
+
+	clients := make(map[int]*model.Client)
+	projects := make(map[int]*model.Project)
+	roles := make(map[int]*model.Role)
+	activities := make(map[int]*model.Activity)
+	for _, report := range reports {
+		if report.ClientID > 0 {
+			clients[report.ClientID] = nil
+		}
+		if report.ProjectID > 0 {
+			projects[report.ProjectID] = nil
+		}
+		if report.RoleID > 0 {
+			roles[report.RoleID] = nil
+		}
+		if report.ActivityID > 0 {
+			activities[report.ActivityID] = nil
+		}
+	}
+
+	err := resolveByID(ctx, clients, s.Client.clients.Collection, options.concurrency,
+		func(ctx context.Context, id int) (*model.Client, error) {
+			res, err := s.Client.Details(id).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			return res.Client, nil
+		})
+	if err != nil {
+		return err
+	}
+	err = resolveByID(ctx, projects, s.Project.projects.Collection, options.concurrency,
+		func(ctx context.Context, id int) (*model.Project, error) {
+			res, err := s.Project.Details(id).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			return res.Project, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	offices := make(map[int]*model.Office)
+	for _, report := range reports {
+		if officeID := reportOfficeID(clients[report.ClientID], projects[report.ProjectID]); officeID > 0 {
+			offices[officeID] = nil
+		}
+	}
+	if len(offices) > 0 {
+		err := resolveByID(ctx, offices, s.Office.offices.Collection, options.concurrency,
+			func(ctx context.Context, id int) (*model.Office, error) {
+				res, err := s.Office.Details(id).Context(ctx).Do()
+				if err != nil {
+					return nil, optional(err)
+				}
+				return res.Office, nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+	if len(roles) > 0 {
+		err := resolveByID(ctx, roles, s.Role.roles.Collection, options.concurrency,
+			func(ctx context.Context, id int) (*model.Role, error) {
+				res, err := s.Role.Details(id).Context(ctx).Do()
+				if err != nil {
+					return nil, optional(err)
+				}
+				return res.Role, nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+	if len(activities) > 0 {
+		err := resolveByID(ctx, activities, s.Activity.activities.Collection, options.concurrency,
+			func(ctx context.Context, id int) (*model.Activity, error) {
+				res, err := s.Activity.Details(id).Context(ctx).Do()
+				if err != nil {
+					return nil, optional(err)
+				}
+				return res.Activity, nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, report := range reports {
+		if client, ok := clients[report.ClientID]; ok {
+			report.Client = client
+		}
+		if project, ok := projects[report.ProjectID]; ok {
+			report.Project = project
+		}
+		if office, ok := offices[reportOfficeID(report.Client, report.Project)]; ok {
+			report.Office = office
+		}
+		if role, ok := roles[report.RoleID]; ok {
+			report.Role = role
+		}
+		if activity, ok := activities[report.ActivityID]; ok {
+			report.Activity = activity
+		}
+	}
+	return nil
+}
+
+// errUnavailable is returned by resolveByID fetch functions for items that
+// don't exist or the member isn't allowed to see
+var errUnavailable = errors.New("glassfactory: related item unavailable")
+
+// optional returns errUnavailable if err is a not found or forbidden error
+func optional(err error) error {
+	if IsNotFound(err) || IsForbidden(err) {
+		return errUnavailable
+	}
+	return err
+}
+
+// resolveByID populates the values of the items map from the cache and fetches
+// the missing items, adding them into the cache. Items for which fetch returns
+// errUnavailable are left with the zero value and are not cached.
+func resolveByID[V any](ctx context.Context, items map[int]V, cache *model.Collection[int, V], workers int, fetch func(ctx context.Context, id int) (V, error)) error {
+	var missing []int
+	for id := range items {
+		if item, ok := cache.Get(id); ok {
+			items[id] = item
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	var mu sync.Mutex
+	var fetched []int
+	err := fetchByID(ctx, missing, workers, func(ctx context.Context, id int) error {
+		item, err := fetch(ctx, id)
+		if errors.Is(err, errUnavailable) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		items[id] = item
+		fetched = append(fetched, id)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range fetched {
+		cache.Add(items[id])
+	}
+	return nil
+}
+
+// reportOfficeID returns the office ID of the project or the client if the
+// project doesn't have an office
+func reportOfficeID(client *model.Client, project *model.Project) int {
+	if project != nil && project.OfficeID > 0 {
+		return project.OfficeID
+	}
+	if client != nil {
+		return client.OfficeID
+	}
+	return 0
+}
+
+// fetchByID calls fetch for each ID using up to the given number of workers
+// and stops after the first error
+func fetchByID(ctx context.Context, ids []int, workers int, fetch func(ctx context.Context, id int) error) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Ints(ids)
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(ids) {
+		workers = len(ids)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	queue := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				if err := fetch(ctx, id); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- id
+	}
+	close(queue)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
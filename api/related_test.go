@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/markosamuli/glassfactory/model"
+	"gotest.tools/assert"
+)
+
+func TestHydrateTimeReports(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			path := strings.TrimPrefix(req.URL.Path, "/api/public/v1/")
+			mu.Lock()
+			requests[path]++
+			mu.Unlock()
+			var id int
+			if _, err := fmt.Sscanf(path[strings.Index(path, "/")+1:], "%d.json", &id); err != nil {
+				return nil, err
+			}
+			if id == 999 {
+				return newStatusResponse(404, nil), nil
+			}
+			return newHTTPResponseWithJSONBody(fmt.Sprintf(`{"id": %d, "name": "%s"}`, id, path)), nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+	s.Client = NewClientService(s)
+	s.Project = NewProjectService(s)
+
+	// Cached clients are not fetched again
+	cached := &model.Client{ID: 3, Name: "Cached Client"}
+	s.Client.clients.Add(cached)
+
+	var reports []*model.MemberTimeReport
+	for i := 0; i < 30; i++ {
+		reports = append(reports, &model.MemberTimeReport{
+			UserID:    123,
+			ClientID:  1 + i%3,
+			ProjectID: 10 + i%5,
+		})
+	}
+	reports = append(reports, &model.MemberTimeReport{UserID: 123})
+
+	err := s.HydrateTimeReports(context.Background(), reports, WithConcurrency(4))
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(requests), 2+5)
+	for path, count := range requests {
+		assert.Equal(t, count, 1, "%s should be requested once", path)
+	}
+	assert.Equal(t, requests["clients/3.json"], 0)
+
+	for _, r := range reports[:30] {
+		assert.Equal(t, r.Client.ID, r.ClientID)
+		assert.Equal(t, r.Project.ID, r.ProjectID)
+	}
+	assert.Equal(t, reports[2].Client, cached)
+	assert.Assert(t, reports[30].Client == nil)
+	assert.Assert(t, reports[30].Project == nil)
+
+	// Fetched details are added into the service cache
+	_, ok := s.Project.projects.Get(14)
+	assert.Assert(t, ok)
+
+	t.Run("error", func(t *testing.T) {
+		reports := []*model.MemberTimeReport{{ClientID: 1, ProjectID: 999}}
+		err := s.HydrateTimeReports(context.Background(), reports, WithConcurrency(2))
+		assert.Assert(t, IsNotFound(err))
+	})
+}
+
+func TestHydrateTimeReports_Unavailable(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			path := strings.TrimPrefix(req.URL.Path, "/api/public/v1/")
+			mu.Lock()
+			requests[path]++
+			mu.Unlock()
+			switch path {
+			case "clients/1.json":
+				return newHTTPResponseWithJSONBody(`{"id": 1, "name": "ACME Inc.", "office_id": 789}`), nil
+			case "projects/10.json":
+				return newHTTPResponseWithJSONBody(`{"id": 10, "name": "Website", "client_id": 1}`), nil
+			case "offices/789.json":
+				return newStatusResponse(403, nil), nil
+			case "roles/5.json":
+				return newStatusResponse(404, nil), nil
+			case "activities/7.json":
+				return newHTTPResponseWithJSONBody(`{"id": 7, "name": "Meetings"}`), nil
+			}
+			return newStatusResponse(500, nil), nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+	s.Client = NewClientService(s)
+	s.Project = NewProjectService(s)
+	s.Office = NewOfficeService(s)
+	s.Role = NewRoleService(s)
+	s.Activity = NewActivityService(s)
+
+	// Offices and roles the member can't access are left nil
+	reports := []*model.MemberTimeReport{{ClientID: 1, ProjectID: 10, RoleID: 5, ActivityID: 7}}
+	err := s.HydrateTimeReports(context.Background(), reports)
+	assert.NilError(t, err)
+	assert.Equal(t, reports[0].Project.Name, "Website")
+	assert.Assert(t, reports[0].Office == nil)
+	assert.Assert(t, reports[0].Role == nil)
+	assert.Equal(t, reports[0].Activity.Name, "Meetings")
+	assert.Equal(t, requests["offices/789.json"], 1)
+	assert.Equal(t, requests["roles/5.json"], 1)
+
+	// Unavailable items are not cached
+	_, ok := s.Role.roles.Get(5)
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// Iterate returns an iterator over member time reports between given dates. The
+// reports are fetched one month at a time as the iterator advances.
+func (r *MemberReportsService) Iterate(ctx context.Context, userID int, start time.Time, end time.Time, opts ...TimeReportOption) *TimeReportIterator {
+	calls := r.TimeReportsBetweenDates(userID, start, end, opts...)
+	return newTimeReportIterator(ctx, calls, opts)
+}
+
+// TimeReportIterator iterates over time reports fetched month by month from Glass Factory.
+//
+//	it := s.Member.Reports.Iterate(ctx, userID, start, end)
+//	for it.Next() {
+//		r := it.Report()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type TimeReportIterator struct {
+	ctx     context.Context
+	calls   *MemberTimeReportCalls
+	options []TimeReportOption
+	next    int // Index of the next call
+	buf     []*model.MemberTimeReport
+	report  *model.MemberTimeReport
+	err     error
+}
+
+func newTimeReportIterator(ctx context.Context, calls *MemberTimeReportCalls, opts []TimeReportOption) *TimeReportIterator {
+	if ctx == nil {
+		ctx = calls.s.defaultContext()
+	}
+	return &TimeReportIterator{
+		ctx:     ctx,
+		calls:   calls,
+		options: opts,
+	}
+}
+
+// Next advances the iterator to the next time report. It returns false when
+// there are no more reports or an error occurred.
+func (it *TimeReportIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.buf) == 0 {
+		if it.next >= len(it.calls.calls) {
+			it.report = nil
+			return false
+		}
+		if it.err = it.fetch(); it.err != nil {
+			it.report = nil
+			return false
+		}
+	}
+	it.report = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// fetch executes the next call and buffers its time reports
+func (it *TimeReportIterator) fetch() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+	c := it.calls.calls[it.next]
+	it.next++
+	res, err := c.Context(it.ctx).Do()
+	if err != nil {
+		return err
+	}
+	// Fetch related data if FetchRelated() option was enabled
+	options := NewTimeReportOptions(it.options)
+	if options.fetchRelated {
+		if err := it.calls.s.HydrateTimeReports(it.ctx, res.Reports, it.options...); err != nil {
+			return err
+		}
+	}
+	it.buf = res.Reports
+	return nil
+}
+
+// Report returns the current time report
+func (it *TimeReportIterator) Report() *model.MemberTimeReport {
+	return it.report
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *TimeReportIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestMemberReportsService_Iterate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/123/reports/time.json").
+		MatchParam("start", "2019-08-01").
+		MatchParam("end", "2019-08-31").
+		Reply(200).
+		BodyString(`[
+		  {"client_id": 2079, "project_id": 14330, "user_id": 123, "date": "2019-08-01", "planned": 8, "time": 5.5},
+		  {"client_id": 2079, "project_id": 14330, "user_id": 123, "date": "2019-08-02", "planned": 8, "time": 7}
+		]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/123/reports/time.json").
+		MatchParam("start", "2019-09-01").
+		MatchParam("end", "2019-09-30").
+		Reply(200).
+		BodyString(`[]`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/123/reports/time.json").
+		MatchParam("start", "2019-10-01").
+		MatchParam("end", "2019-10-31").
+		Reply(200).
+		BodyString(`[
+		  {"client_id": 2079, "project_id": 14330, "user_id": 123, "date": "2019-10-01", "planned": 8, "time": 8}
+		]`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	start := time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.October, 31, 0, 0, 0, 0, time.UTC)
+	it := s.Member.Reports.Iterate(context.Background(), 123, start, end)
+
+	// Reports are fetched one month at a time
+	assert.Assert(t, it.Next())
+	assert.Equal(t, it.Report().Date.Day, 1)
+	assert.Equal(t, len(gock.Pending()), 2)
+
+	var actual float64
+	actual += it.Report().Actual
+	for it.Next() {
+		actual += it.Report().Actual
+	}
+	assert.NilError(t, it.Err())
+	assert.Equal(t, actual, 20.5)
+	assert.Assert(t, it.Report() == nil)
+	assert.Assert(t, !it.Next())
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestMemberReportsService_IterateError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/members/123/reports/time.json").
+		Reply(404).
+		BodyString(`{"error": "Not found"}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	start := time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 30, 0, 0, 0, 0, time.UTC)
+	it := s.Member.Reports.Iterate(context.Background(), 123, start, end)
+	assert.Assert(t, !it.Next())
+	assert.Assert(t, IsNotFound(it.Err()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = s.Member.Reports.Iterate(ctx, 123, start, end)
+	assert.Assert(t, !it.Next())
+	assert.Assert(t, errors.Is(it.Err(), context.Canceled))
+}
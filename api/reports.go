@@ -1,12 +1,14 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/civil"
@@ -30,37 +32,34 @@ type MemberReportsService struct {
 
 // GetTimeReportsBetweenDates returns Glass Factory member time reports between given dates
 func (r *MemberReportsService) GetTimeReportsBetweenDates(userID int, start time.Time, end time.Time, opts ...TimeReportOption) ([]*model.MemberTimeReport, error) {
-	responses, err := r.TimeReportsBetweenDates(userID, start, end, opts...).Do()
-	if err != nil {
-		return nil, err
-	}
-
-	options := NewTimeReportOptions(opts)
+	return r.GetTimeReportsBetweenDatesContext(r.m.s.defaultContext(), userID, start, end, opts...)
+}
 
-	reports := make([]*model.MemberTimeReport, 0)
-	for _, response := range responses {
-		for _, report := range response.Reports {
-			// Fetch related data if FetchRelated() option was enabled
-			if options.fetchRelated {
-				client, err := r.m.s.Client.Get(report.ClientID)
-				if err != nil {
-					return nil, err
-				}
-				project, err := r.m.s.Project.Get(report.ProjectID)
-				if err != nil {
-					return nil, err
-				}
-				report.Client = client
-				report.Project = project
-			}
-			reports = append(reports, report)
-		}
-	}
-	return reports, nil
+// GetTimeReportsBetweenDatesContext returns Glass Factory member time reports between given dates
+// using the given context
+func (r *MemberReportsService) GetTimeReportsBetweenDatesContext(ctx context.Context, userID int, start time.Time, end time.Time, opts ...TimeReportOption) ([]*model.MemberTimeReport, error) {
+	calls := r.TimeReportsBetweenDates(userID, start, end, opts...)
+	return calls.s.collectTimeReports(ctx, calls, opts)
 }
 
 // TimeReport queries Glass Factory and returns member time reports for the given time period
 func (r *MemberReportsService) TimeReport(userID int, start time.Time, end time.Time, opts ...TimeReportOption) *MemberTimeReportCall {
+	c := newTimeReportCall(r.m.s, start, end, opts)
+	c.userID = userID
+	return c
+}
+
+// TimeReportsBetweenDates creates MemberTimeReportCalls to be used for fetching member time reports between the given dates
+func (r *MemberReportsService) TimeReportsBetweenDates(userID int, start time.Time, end time.Time, opts ...TimeReportOption) *MemberTimeReportCalls {
+	calls := newTimeReportCalls(r.m.s, start, end, opts, func(c *MemberTimeReportCall) {
+		c.userID = userID
+	})
+	calls.userID = userID
+	return calls
+}
+
+// newTimeReportCall creates a time report call for the given time period
+func newTimeReportCall(s *Service, start time.Time, end time.Time, opts []TimeReportOption) *MemberTimeReportCall {
 	today := time.Now()
 	if start.After(today) {
 		start = today // Make sure we're not getting reports from the future
@@ -68,16 +67,16 @@ func (r *MemberReportsService) TimeReport(userID int, start time.Time, end time.
 	if end.After(today) {
 		end = today // Make sure we're not getting reports from the future
 	}
-	c := &MemberTimeReportCall{s: r.m.s}
-	c.userID = userID
+	c := &MemberTimeReportCall{s: s}
 	c.start = civil.DateOf(start)
 	c.end = civil.DateOf(end)
 	c.options = opts
 	return c
 }
 
-// TimeReportsBetweenDates creates MemberTimeReportCalls to be used for fetching member time reports between the given dates
-func (r *MemberReportsService) TimeReportsBetweenDates(userID int, start time.Time, end time.Time, opts ...TimeReportOption) *MemberTimeReportCalls {
+// newTimeReportCalls creates time report calls for each month between the given
+// dates, calling init on each of them
+func newTimeReportCalls(s *Service, start time.Time, end time.Time, opts []TimeReportOption, init func(c *MemberTimeReportCall)) *MemberTimeReportCalls {
 	today := time.Now()
 	if start.After(today) {
 		start = today // Make sure we're not getting reports from the future
@@ -85,8 +84,7 @@ func (r *MemberReportsService) TimeReportsBetweenDates(userID int, start time.Ti
 	if end.After(today) {
 		end = today // Make sure we're not getting reports from the future
 	}
-	calls := &MemberTimeReportCalls{s: r.m.s}
-	calls.userID = userID
+	calls := &MemberTimeReportCalls{s: s}
 	calls.options = opts
 	// Split calls into months for better performance
 	for _, m := range dateutil.MonthsBetweenDates(start, end) {
@@ -97,15 +95,40 @@ func (r *MemberReportsService) TimeReportsBetweenDates(userID int, start time.Ti
 		if m.End.After(end) {
 			m.End = end
 		}
-		c := r.TimeReport(userID, m.Start, m.End, opts...)
+		c := newTimeReportCall(s, m.Start, m.End, opts)
+		init(c)
 		calls.Append(c)
 	}
 	return calls
 }
 
+// collectTimeReports executes the calls and returns the time reports from all
+// responses, fetching the related data if FetchRelated() option was enabled
+func (s *Service) collectTimeReports(ctx context.Context, calls *MemberTimeReportCalls, opts []TimeReportOption) ([]*model.MemberTimeReport, error) {
+	responses, err := calls.Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*model.MemberTimeReport, 0)
+	for _, response := range responses {
+		reports = append(reports, response.Reports...)
+	}
+
+	// Fetch related data if FetchRelated() option was enabled
+	options := NewTimeReportOptions(opts)
+	if options.fetchRelated {
+		if err := s.HydrateTimeReports(ctx, reports, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return reports, nil
+}
+
 // MemberTimeReportCall is used for fetching time reports for given time period from Glass Factory
 type MemberTimeReportCall struct {
 	s       *Service
+	ctx     context.Context
 	userID  int        // User ID
 	start   civil.Date // Range start date
 	end     civil.Date // Range end date
@@ -113,6 +136,12 @@ type MemberTimeReportCall struct {
 	options []TimeReportOption
 }
 
+// Context sets the context to be used in this call's Do method
+func (c *MemberTimeReportCall) Context(ctx context.Context) *MemberTimeReportCall {
+	c.ctx = ctx
+	return c
+}
+
 // Options returns request options with defaults
 func (c *MemberTimeReportCall) Options() TimeReportOptions {
 	options := TimeReportOptions{}
@@ -163,12 +192,11 @@ func (c *MemberTimeReportCall) doRequest() (*http.Response, error) {
 	}
 
 	urls += "?" + urlParams.Encode()
-	req, err := http.NewRequest(http.MethodGet, urls, nil)
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	res, err := c.s.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -183,6 +211,10 @@ func (c *MemberTimeReportCall) Do() (*MemberTimeReportResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
 	target := make([]*model.MemberTimeReport, 0)
 	if err := DecodeResponse(&target, res); err != nil {
 		return nil, err
@@ -195,6 +227,7 @@ func (c *MemberTimeReportCall) Do() (*MemberTimeReportResponse, error) {
 // MemberTimeReportCalls is used for fetching multiple time reports from Glass Factory
 type MemberTimeReportCalls struct {
 	s       *Service
+	ctx     context.Context
 	userID  int
 	start   civil.Date
 	end     civil.Date
@@ -202,6 +235,12 @@ type MemberTimeReportCalls struct {
 	calls   []*MemberTimeReportCall
 }
 
+// Context sets the context to be used for all the calls in the Do method
+func (m *MemberTimeReportCalls) Context(ctx context.Context) *MemberTimeReportCalls {
+	m.ctx = ctx
+	return m
+}
+
 // Append additional MemberTimeReportCall to the list of calls
 func (m *MemberTimeReportCalls) Append(c *MemberTimeReportCall) {
 	if !m.start.IsValid() || c.start.Before(m.start) {
@@ -213,10 +252,18 @@ func (m *MemberTimeReportCalls) Append(c *MemberTimeReportCall) {
 	m.calls = append(m.calls, c)
 }
 
-// Do executes all the queries in MemberTimeReportCalls
+// Do executes all the queries in MemberTimeReportCalls. The responses are
+// returned in the same order as the calls were appended.
 func (m *MemberTimeReportCalls) Do() ([]*MemberTimeReportResponse, error) {
+	options := NewTimeReportOptions(m.options)
+	if options.concurrency > 1 && len(m.calls) > 1 {
+		return m.doConcurrent(options.concurrency)
+	}
 	responses := make([]*MemberTimeReportResponse, len(m.calls))
 	for i, c := range m.calls {
+		if m.ctx != nil {
+			c.Context(m.ctx)
+		}
 		res, err := c.Do()
 		if err != nil {
 			return nil, err
@@ -225,3 +272,57 @@ func (m *MemberTimeReportCalls) Do() ([]*MemberTimeReportResponse, error) {
 	}
 	return responses, nil
 }
+
+// doConcurrent executes the calls using a pool of workers and cancels
+// the remaining calls after the first error
+func (m *MemberTimeReportCalls) doConcurrent(workers int) ([]*MemberTimeReportResponse, error) {
+	if workers > len(m.calls) {
+		workers = len(m.calls)
+	}
+	ctx := m.ctx
+	if ctx == nil {
+		ctx = m.s.defaultContext()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	responses := make([]*MemberTimeReportResponse, len(m.calls))
+	indexes := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				res, err := m.calls[i].Context(ctx).Do()
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				responses[i] = res
+			}
+		}()
+	}
+	for i := range m.calls {
+		if ctx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
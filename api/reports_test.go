@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -176,6 +178,14 @@ func TestGetTimeReportsBetweenDates(t *testing.T) {
           "name": "Test Project"
 		}`)
 
+	gock.New(domain).
+		Get(apiPath + fmt.Sprintf("roles/%d.json", 1480)).
+		Reply(200).
+		BodyString(`{
+		  "id": 1480,
+		  "name": "Test Role"
+		}`)
+
 	gock.New(domain).
 		Get(apiPath+fmt.Sprintf("members/%d/reports/time.json", userID)).
 		MatchParam("start", "2019-09-01").
@@ -198,6 +208,9 @@ func TestGetTimeReportsBetweenDates(t *testing.T) {
 	s.BasePath = endpoint
 	s.Client = NewClientService(s)
 	s.Project = NewProjectService(s)
+	s.Office = NewOfficeService(s)
+	s.Role = NewRoleService(s)
+	s.Activity = NewActivityService(s)
 
 	var reports []*model.MemberTimeReport
 	var err error
@@ -222,7 +235,90 @@ func TestGetTimeReportsBetweenDates(t *testing.T) {
 	assert.Equal(t, reports[0].Client.Name, "Test Client")
 	assert.Equal(t, reports[0].Project.ID, 222)
 	assert.Equal(t, reports[0].Project.Name, "Test Project")
+	assert.Equal(t, reports[0].Role.Name, "Test Role")
 
 	// Verify that we don't have pending mocks
 	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
 }
+
+func TestTimeReportsBetweenDatesContext(t *testing.T) {
+	userID := 123
+	requests := 0
+
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if err := req.Context().Err(); err != nil {
+				return nil, err
+			}
+			return newHTTPResponseWithJSONBody(`[]`), nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+
+	ms := NewMemberService(s)
+	rs := NewMemberReportsService(ms)
+
+	start := time.Date(2019, time.August, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.September, 15, 0, 0, 0, 0, time.UTC)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := rs.GetTimeReportsBetweenDatesContext(ctx, userID, start, end)
+	assert.ErrorContains(t, err, context.Canceled.Error())
+	assert.Equal(t, requests, 1, "calls should stop after the first error")
+}
+
+func TestTimeReportsBetweenDatesWithConcurrency(t *testing.T) {
+	userID := 123
+
+	var inFlight, maxInFlight int32
+	s := &Service{}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			start := req.URL.Query().Get("start")
+			if start == "2019-05-01" {
+				return newStatusResponse(500, nil), nil
+			}
+			return newHTTPResponseWithJSONBody(fmt.Sprintf(`[{"user_id": 123, "date": "%s", "time": 1}]`, start)), nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+
+	ms := NewMemberService(s)
+	rs := NewMemberReportsService(ms)
+
+	t.Run("results in month order", func(t *testing.T) {
+		atomic.StoreInt32(&maxInFlight, 0)
+		start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC)
+
+		res, err := rs.TimeReportsBetweenDates(userID, start, end, WithConcurrency(2)).Do()
+		assert.NilError(t, err)
+		assert.Equal(t, len(res), 4)
+		for i, r := range res {
+			assert.Equal(t, len(r.Reports), 1)
+			assert.Equal(t, int(r.Reports[0].Date.Month), i+1)
+		}
+		assert.Equal(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	})
+
+	t.Run("stop on first error", func(t *testing.T) {
+		start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+		_, err := rs.TimeReportsBetweenDates(userID, start, end, WithConcurrency(3)).Do()
+		assert.Assert(t, IsServerError(err))
+	})
+}
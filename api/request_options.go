@@ -1,10 +1,23 @@
 package api
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // RequestOptions represent the available options on the service requests
 type RequestOptions struct {
-	cache  bool
-	term   string
-	status string
+	cache        bool
+	term         string
+	status       string
+	perPage      int
+	officeID     int       // Office ID
+	managerID    int       // Project manager's user ID
+	clientID     int       // Client ID
+	archived     *bool     // Archived status, nil returns both
+	closed       *bool     // Closed status, nil returns both
+	updatedSince time.Time // Return only resources updated after the time
 }
 
 func (options *RequestOptions) apply(opts []RequestOption) {
@@ -45,6 +58,77 @@ func WithTerm(term string) RequestOption {
 	})
 }
 
+// WithPageSize returns list requests with the given number of results per page
+func WithPageSize(perPage int) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.perPage = perPage
+	})
+}
+
+// WithOfficeFilter returns resources in the given office
+func WithOfficeFilter(officeID int) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.officeID = officeID
+	})
+}
+
+// WithManagerFilter returns projects managed by the given member
+func WithManagerFilter(userID int) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.managerID = userID
+	})
+}
+
+// WithClientFilter returns projects of the given client
+func WithClientFilter(clientID int) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.clientID = clientID
+	})
+}
+
+// WithArchived returns only archived or only non-archived resources
+func WithArchived(archived bool) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.archived = &archived
+	})
+}
+
+// WithClosed returns only closed or only open projects
+func WithClosed(closed bool) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.closed = &closed
+	})
+}
+
+// WithUpdatedSince returns resources updated after the given time
+func WithUpdatedSince(t time.Time) RequestOption {
+	return optionFunc(func(o *RequestOptions) {
+		o.updatedSince = t
+	})
+}
+
+// addFilterParams adds the server-side filters as query parameters
+func (options *RequestOptions) addFilterParams(urlParams url.Values) {
+	if options.officeID > 0 {
+		urlParams.Add("office_id", strconv.Itoa(options.officeID))
+	}
+	if options.managerID > 0 {
+		urlParams.Add("manager_id", strconv.Itoa(options.managerID))
+	}
+	if options.clientID > 0 {
+		urlParams.Add("client_id", strconv.Itoa(options.clientID))
+	}
+	if options.archived != nil {
+		urlParams.Add("archived", strconv.FormatBool(*options.archived))
+	}
+	if options.closed != nil {
+		urlParams.Add("closed", strconv.FormatBool(*options.closed))
+	}
+	if !options.updatedSince.IsZero() {
+		urlParams.Add("updated_since", options.updatedSince.UTC().Format(time.RFC3339))
+	}
+}
+
 // NewRequestOptions returns RequestOptions with defaults
 func NewRequestOptions(opts []RequestOption) *RequestOptions {
 	options := &RequestOptions{
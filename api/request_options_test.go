@@ -1,7 +1,9 @@
 package api
 
 import (
+	"net/url"
 	"testing"
+	"time"
 
 	"gotest.tools/assert"
 )
@@ -134,3 +136,40 @@ func TestWithTerm(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterParams(t *testing.T) {
+	updated := time.Date(2020, time.January, 6, 9, 30, 0, 0, time.FixedZone("EET", 2*60*60))
+	var tests = []struct {
+		name     string
+		opts     []RequestOption
+		expected string
+	}{
+		{
+			name:     "no filters by default",
+			opts:     nil,
+			expected: "",
+		},
+		{
+			name:     "office, manager and client",
+			opts:     []RequestOption{WithOfficeFilter(10), WithManagerFilter(100), WithClientFilter(1)},
+			expected: "client_id=1&manager_id=100&office_id=10",
+		},
+		{
+			name:     "archived and closed status",
+			opts:     []RequestOption{WithArchived(false), WithClosed(true)},
+			expected: "archived=false&closed=true",
+		},
+		{
+			name:     "updated since in UTC",
+			opts:     []RequestOption{WithUpdatedSince(updated)},
+			expected: "updated_since=2020-01-06T07%3A30%3A00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urlParams := url.Values{}
+			NewRequestOptions(tt.opts).addFilterParams(urlParams)
+			assert.Equal(t, urlParams.Encode(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy defines how failed requests are retried
+type RetryPolicy struct {
+	MaxAttempts int           // Maximum number of attempts including the first request
+	MinBackoff  time.Duration // Delay before the first retry
+	MaxBackoff  time.Duration // Maximum delay between retries
+	Jitter      float64       // Randomisation factor between 0 and 1 applied to the delay
+}
+
+// DefaultRetryPolicy returns the retry policy used when none has been configured
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Backoff returns the delay before the given retry attempt, starting from 1
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d -= d * p.Jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// RetryTransport retries idempotent requests that failed with a network error,
+// a 429 Too Many Requests or a 5xx server error response
+type RetryTransport struct {
+	Base   http.RoundTripper // Transport used for the requests, defaults to http.DefaultTransport
+	Policy RetryPolicy
+
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetryTransport creates a new RetryTransport with the given policy
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	return &RetryTransport{Base: base, Policy: policy}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip executes the request and retries it according to the retry policy
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req.Method) || (req.Body != nil && req.GetBody == nil) {
+		return t.base().RoundTrip(req)
+	}
+	sleep := t.sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		// Send a copy of the request so that the caller's request isn't modified
+		r := req.Clone(ctx)
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+		res, err := t.base().RoundTrip(r)
+		if attempt >= t.Policy.MaxAttempts || !shouldRetry(ctx, res, err) {
+			return res, err
+		}
+		delay := t.Policy.Backoff(attempt)
+		if res != nil {
+			if retryAfter := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); retryAfter > delay {
+				delay = retryAfter
+			}
+			drainBody(res.Body)
+		}
+		if t.Policy.MaxBackoff > 0 && delay > t.Policy.MaxBackoff {
+			delay = t.Policy.MaxBackoff
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isIdempotent reports whether requests using the method can be safely retried
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether the request should be retried based on the result
+func shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode == http.StatusNotImplemented:
+		return false
+	case res.StatusCode >= 500 && res.StatusCode <= 599:
+		return true
+	}
+	return false
+}
+
+// drainBody reads and closes the response body so the connection can be reused
+func drainBody(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(body, maxErrorBodySize))
+	body.Close()
+}
+
+// sleepContext pauses for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
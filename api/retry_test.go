@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func newStatusResponse(code int, header http.Header) *http.Response {
+	res := newHTTPResponseWithJSONBody(`{}`)
+	res.StatusCode = code
+	res.Status = http.StatusText(code)
+	for k, v := range header {
+		res.Header[k] = v
+	}
+	return res
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  time.Second,
+		MaxBackoff:  5 * time.Second,
+	}
+	assert.Equal(t, policy.Backoff(1), time.Second)
+	assert.Equal(t, policy.Backoff(2), 2*time.Second)
+	assert.Equal(t, policy.Backoff(3), 4*time.Second)
+	assert.Equal(t, policy.Backoff(4), 5*time.Second)
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		d := policy.Backoff(1)
+		assert.Assert(t, d > 500*time.Millisecond && d <= time.Second)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Second,
+		MaxBackoff:  10 * time.Second,
+	}
+
+	var tests = []struct {
+		name       string
+		method     string
+		responses  []*http.Response
+		errors     []error
+		wantStatus int
+		wantErr    string
+		wantSleeps []time.Duration
+	}{
+		{
+			name:       "success",
+			method:     http.MethodGet,
+			responses:  []*http.Response{newStatusResponse(200, nil)},
+			wantStatus: 200,
+		},
+		{
+			name:   "retry server errors",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				newStatusResponse(502, nil),
+				newStatusResponse(503, nil),
+				newStatusResponse(200, nil),
+			},
+			wantStatus: 200,
+			wantSleeps: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "give up after max attempts",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				newStatusResponse(500, nil),
+				newStatusResponse(500, nil),
+				newStatusResponse(500, nil),
+			},
+			wantStatus: 500,
+			wantSleeps: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "honour Retry-After",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				newStatusResponse(429, http.Header{"Retry-After": []string{"7"}}),
+				newStatusResponse(200, nil),
+			},
+			wantStatus: 200,
+			wantSleeps: []time.Duration{7 * time.Second},
+		},
+		{
+			name:   "cap Retry-After at max backoff",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				newStatusResponse(429, http.Header{"Retry-After": []string{"3600"}}),
+				newStatusResponse(200, nil),
+			},
+			wantStatus: 200,
+			wantSleeps: []time.Duration{10 * time.Second},
+		},
+		{
+			name:   "retry network errors",
+			method: http.MethodGet,
+			responses: []*http.Response{
+				nil,
+				newStatusResponse(200, nil),
+			},
+			errors:     []error{errors.New("connection reset"), nil},
+			wantStatus: 200,
+			wantSleeps: []time.Duration{time.Second},
+		},
+		{
+			name:       "do not retry client errors",
+			method:     http.MethodGet,
+			responses:  []*http.Response{newStatusResponse(404, nil)},
+			wantStatus: 404,
+		},
+		{
+			name:       "do not retry non-idempotent requests",
+			method:     http.MethodPost,
+			responses:  []*http.Response{newStatusResponse(503, nil)},
+			wantStatus: 503,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sleeps []time.Duration
+			attempt := 0
+			trans := NewRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				defer func() { attempt++ }()
+				assert.Assert(t, attempt < len(tt.responses), "unexpected request")
+				var err error
+				if attempt < len(tt.errors) {
+					err = tt.errors[attempt]
+				}
+				return tt.responses[attempt], err
+			}), policy)
+			trans.sleep = func(ctx context.Context, d time.Duration) error {
+				sleeps = append(sleeps, d)
+				return nil
+			}
+
+			req, err := http.NewRequest(tt.method, "https://example.glassfactory.io/api/public/v1/clients.json", nil)
+			assert.NilError(t, err)
+
+			res, err := trans.RoundTrip(req)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, res.StatusCode, tt.wantStatus)
+			assert.Equal(t, attempt, len(tt.responses))
+			assert.DeepEqual(t, sleeps, tt.wantSleeps)
+		})
+	}
+}
+
+func TestRetryTransport_RequestBody(t *testing.T) {
+	var bodies []string
+	var requests []*http.Request
+	trans := NewRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		data, err := ioutil.ReadAll(req.Body)
+		assert.NilError(t, err)
+		bodies = append(bodies, string(data))
+		requests = append(requests, req)
+		if len(bodies) == 1 {
+			return newStatusResponse(503, nil), nil
+		}
+		return newStatusResponse(200, nil), nil
+	}), RetryPolicy{MaxAttempts: 2})
+	trans.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+
+	req, err := http.NewRequest(http.MethodPut, "https://example.glassfactory.io/api/public/v1/members/1/time_entries/1.json", strings.NewReader(`{"hours": 1}`))
+	assert.NilError(t, err)
+	body := req.Body
+
+	res, err := trans.RoundTrip(req)
+	assert.NilError(t, err)
+	assert.Equal(t, res.StatusCode, 200)
+	assert.DeepEqual(t, bodies, []string{`{"hours": 1}`, `{"hours": 1}`})
+
+	// The caller's request is not modified
+	assert.Equal(t, req.Body, body)
+	for _, r := range requests {
+		assert.Assert(t, r != req)
+	}
+}
+
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	trans := NewRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		cancel()
+		return newStatusResponse(503, nil), nil
+	}), *DefaultRetryPolicy())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.glassfactory.io/", nil)
+	assert.NilError(t, err)
+
+	res, err := trans.RoundTrip(req)
+	assert.NilError(t, err)
+	assert.Equal(t, res.StatusCode, 503)
+	assert.Equal(t, attempts, 1)
+}
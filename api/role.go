@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// NewRoleService initialises a new RoleService
+func NewRoleService(s *Service) *RoleService {
+	rs := &RoleService{s: s}
+	rs.roles = model.NewRoleCollection()
+	return rs
+}
+
+// RoleService is used for calling the Glass Factory role APIs
+type RoleService struct {
+	s     *Service
+	roles *model.RoleCollection
+}
+
+// All returns all roles in the Glass Factory account
+func (r *RoleService) All(opts ...RequestOption) ([]*model.Role, error) {
+	return r.AllContext(r.s.defaultContext(), opts...)
+}
+
+// AllContext returns all roles in the Glass Factory account using the given context
+func (r *RoleService) AllContext(ctx context.Context, opts ...RequestOption) ([]*model.Role, error) {
+	res, err := r.List(opts...).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return res.Roles, nil
+}
+
+// Get returns a role from Glass Factory
+func (r *RoleService) Get(roleID int, opts ...RequestOption) (*model.Role, error) {
+	return r.GetContext(r.s.defaultContext(), roleID, opts...)
+}
+
+// GetContext returns a role from Glass Factory using the given context
+func (r *RoleService) GetContext(ctx context.Context, roleID int, opts ...RequestOption) (*model.Role, error) {
+	options := NewRequestOptions(opts)
+	if options.cache {
+		role, ok := r.roles.Get(roleID)
+		if ok {
+			return role, nil
+		}
+	}
+	res, err := r.Details(roleID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if options.cache {
+		r.roles.Add(res.Role)
+	}
+	return res.Role, nil
+}
+
+// Details returns role details from Glass Factory
+func (r *RoleService) Details(roleID int) *RoleDetailsCall {
+	c := &RoleDetailsCall{s: r.s}
+	c.roleID = roleID
+	return c
+}
+
+// List returns a list of roles in the Glass Factory account
+func (r *RoleService) List(opts ...RequestOption) *RoleListCall {
+	c := &RoleListCall{s: r.s}
+	c.options = opts
+	return c
+}
+
+// RoleDetailsCall represents a request to Role Details API
+type RoleDetailsCall struct {
+	s      *Service
+	ctx    context.Context
+	roleID int
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *RoleDetailsCall) Context(ctx context.Context) *RoleDetailsCall {
+	c.ctx = ctx
+	return c
+}
+
+// RoleDetailsResponse represents a response from Role Details API
+type RoleDetailsResponse struct {
+	Role *model.Role
+}
+
+func (c *RoleDetailsCall) doRequest() (*http.Response, error) {
+	var urls string
+	if c.roleID > 0 {
+		urls = c.s.BasePath + fmt.Sprintf("roles/%d.json", c.roleID)
+	} else {
+		return nil, errors.New("role ID is required")
+	}
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *RoleDetailsCall) Do() (*RoleDetailsResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	var target model.Role
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &RoleDetailsResponse{}
+	ret.Role = &target
+	return ret, nil
+}
+
+// RoleListCall represents a request to List Account's Roles API
+type RoleListCall struct {
+	s       *Service
+	ctx     context.Context
+	options []RequestOption
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *RoleListCall) Context(ctx context.Context) *RoleListCall {
+	c.ctx = ctx
+	return c
+}
+
+// Options returns request options with defaults
+func (c *RoleListCall) Options() RequestOptions {
+	options := RequestOptions{}
+	options.apply(c.options)
+	return options
+}
+
+// RoleListResponse represents a response from List Account's Roles API
+type RoleListResponse struct {
+	Roles []*model.Role
+}
+
+func (c *RoleListCall) doRequest() (*http.Response, error) {
+	var urls string
+	urls = c.s.BasePath + "roles.json"
+
+	options := c.Options()
+
+	urlParams := url.Values{}
+	if options.term != "" {
+		urlParams.Add("term", options.term)
+	}
+	urls += "?" + urlParams.Encode()
+
+	req, err := c.s.newRequest(c.ctx, http.MethodGet, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and parses results
+func (c *RoleListCall) Do() (*RoleListResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	target := make([]*model.Role, 0)
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &RoleListResponse{}
+	ret.Roles = target
+	return ret, nil
+}
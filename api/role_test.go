@@ -0,0 +1,80 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func TestGetRole(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+	roleID := 1480
+
+	gock.New(domain).
+		Get(apiPath + fmt.Sprintf("roles/%d.json", roleID)).
+		Reply(200).
+		BodyString(`{
+			"id": 1480,
+			"name": "Designer",
+			"rate": 95.5,
+			"currency": "GBP"
+		}`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewRoleService(s)
+
+	role, err := rs.Get(roleID)
+	assert.NilError(t, err)
+	assert.Equal(t, role.ID, roleID)
+	assert.Equal(t, role.Name, "Designer")
+	assert.Equal(t, role.Rate, 95.5)
+	assert.Equal(t, role.Currency, "GBP")
+
+	// Second call is served from the cache
+	cached, err := rs.Get(roleID)
+	assert.NilError(t, err)
+	assert.Equal(t, cached, role)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestListRoles(t *testing.T) {
+	defer gock.Off()
+
+	domain := "https://example.glassfactory.io"
+	apiPath := "/api/public/v1/"
+	endpoint := domain + apiPath
+
+	gock.New(domain).
+		Get(apiPath + "roles.json").
+		Reply(200).
+		BodyString(`[
+			{"id": 1480, "name": "Designer"},
+			{"id": 1481, "name": "Developer"}
+		]`)
+
+	s := &Service{}
+	s.client = &http.Client{}
+	s.BasePath = endpoint
+
+	rs := NewRoleService(s)
+
+	roles, err := rs.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(roles), 2)
+	assert.Equal(t, roles[1].Name, "Developer")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
@@ -1,25 +1,32 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/markosamuli/glassfactory/model"
 )
 
 // NewService creates a new Service.
-func NewService(ctx context.Context, settings *Settings) (*Service, error) {
-	client, endpoint, err := NewClient(ctx, settings)
+func NewService(ctx context.Context, settings *Settings, opts ...ClientOption) (*Service, error) {
+	client, endpoint, err := NewClient(ctx, settings, opts...)
 	if err != nil {
 		return nil, err
 	}
-	s := &Service{client: client}
+	s := &Service{client: client, ctx: ctx}
 	s.Client = NewClientService(s)
 	s.Member = NewMemberService(s)
 	s.Project = NewProjectService(s)
+	s.Office = NewOfficeService(s)
+	s.Role = NewRoleService(s)
+	s.Activity = NewActivityService(s)
+	s.TimeEntry = NewTimeEntryService(s)
+	s.Allocation = NewAllocationService(s)
 	if endpoint != "" {
 		s.BasePath = endpoint
 	}
@@ -30,17 +37,29 @@ func NewService(ctx context.Context, settings *Settings) (*Service, error) {
 // Service is used for calling Glass Factory APIs
 type Service struct {
 	client        *http.Client
+	ctx           context.Context
 	settings      *Settings
 	currentMember *model.Member
 	BasePath      string // Base URL for the API
 
-	Client  *ClientService
-	Member  *MemberService
-	Project *ProjectService
+	Client     *ClientService
+	Member     *MemberService
+	Project    *ProjectService
+	Office     *OfficeService
+	Role       *RoleService
+	Activity   *ActivityService
+	TimeEntry  *TimeEntryService
+	Allocation *AllocationService
 }
 
 // GetCurrentMember returns a member matching the user email address in settings
 func (s *Service) GetCurrentMember() (*model.Member, error) {
+	return s.GetCurrentMemberContext(s.defaultContext())
+}
+
+// GetCurrentMemberContext returns a member matching the user email address in settings
+// using the given context for the API requests
+func (s *Service) GetCurrentMemberContext(ctx context.Context) (*model.Member, error) {
 	// Get user email from settings
 	email := s.settings.UserEmail
 	if email == "" {
@@ -51,7 +70,7 @@ func (s *Service) GetCurrentMember() (*model.Member, error) {
 		return s.currentMember, nil
 	}
 	// Get active members without caching them
-	members, err := s.Member.Active(WithCache(false))
+	members, err := s.Member.ActiveContext(ctx, WithCache(false))
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +84,42 @@ func (s *Service) GetCurrentMember() (*model.Member, error) {
 	return nil, fmt.Errorf("no users matching email %s found", email)
 }
 
+// defaultContext returns the context given to NewService or a background context
+// if the Service was created without one
+func (s *Service) defaultContext() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// newRequest creates a new API request using the given context. If ctx is nil,
+// the context given to NewService is used instead.
+func (s *Service) newRequest(ctx context.Context, method string, urls string) (*http.Request, error) {
+	return s.newRequestWithBody(ctx, method, urls, nil)
+}
+
+// newJSONRequest creates a new API request with the JSON encoded body
+func (s *Service) newJSONRequest(ctx context.Context, method string, urls string, body interface{}) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return s.newRequestWithBody(ctx, method, urls, bytes.NewReader(data))
+}
+
+func (s *Service) newRequestWithBody(ctx context.Context, method string, urls string, body io.Reader) (*http.Request, error) {
+	if ctx == nil {
+		ctx = s.defaultContext()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, urls, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
 // DecodeResponse decodes the body of res into target. If there is no body,
 // target is unchanged.
 func DecodeResponse(target interface{}, res *http.Response) error {
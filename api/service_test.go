@@ -117,6 +117,48 @@ func newHTTPResponseWithJSONBody(body string) *http.Response {
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type testContextKey struct{}
+
+func TestServiceContext(t *testing.T) {
+	serviceCtx := context.WithValue(context.Background(), testContextKey{}, "service")
+	callCtx := context.WithValue(context.Background(), testContextKey{}, "call")
+
+	var got interface{}
+	s := &Service{ctx: serviceCtx}
+	s.client = &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			got = req.Context().Value(testContextKey{})
+			return newHTTPResponseWithJSONBody(`{"id": 1234}`), nil
+		}),
+	}
+	s.BasePath = "https://example.glassfactory.io/api/public/v1/"
+	rs := NewClientService(s)
+
+	t.Run("service context", func(t *testing.T) {
+		_, err := rs.Details(1234).Do()
+		assert.NilError(t, err)
+		assert.Equal(t, got, "service")
+	})
+
+	t.Run("call context", func(t *testing.T) {
+		_, err := rs.Details(1234).Context(callCtx).Do()
+		assert.NilError(t, err)
+		assert.Equal(t, got, "call")
+	})
+
+	t.Run("service method context", func(t *testing.T) {
+		_, err := rs.GetContext(callCtx, 1234, WithCache(false))
+		assert.NilError(t, err)
+		assert.Equal(t, got, "call")
+	})
+}
+
 func TestDecodeResponse(t *testing.T) {
 	var tests = []struct {
 		name   string
@@ -2,6 +2,8 @@ package api
 
 import (
 	"errors"
+	"io"
+	"net/url"
 )
 
 // Settings for the HTTP client
@@ -9,6 +11,15 @@ type Settings struct {
 	UserEmail        string
 	UserToken        string
 	AccountSubdomain string
+	BaseURL          string       // API base URL, defaults to the public API of the account subdomain
+	Retry            *RetryPolicy // Retry policy for failed requests, DefaultRetryPolicy() is used if nil
+	RateLimit        float64      // Maximum number of requests per second, zero disables rate limiting
+	RateBurst        int          // Maximum number of requests sent at once when rate limiting is enabled
+	Cache            Cache        // Cache for API responses, responses are not cached if nil
+	CacheTTL         *CacheTTL    // Cache TTLs for each resource type, DefaultCacheTTL() is used if nil
+	Offline          bool         // Serve responses only from the cache without calling the API
+	DebugLog         io.Writer    // Writer for logging the API requests, requests are not logged if nil
+	DebugJSON        bool         // Log the API requests as JSON lines
 }
 
 // NewSettings creates new Settings for API authentication and validates them
@@ -35,5 +46,17 @@ func (s *Settings) Validate() error {
 	if s.AccountSubdomain == "" {
 		return errors.New("account subdomain missing")
 	}
+	if s.BaseURL != "" {
+		u, err := url.Parse(s.BaseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("invalid base URL")
+		}
+	}
+	if s.RateLimit < 0 {
+		return errors.New("rate limit can't be negative")
+	}
+	if s.Offline && s.Cache == nil {
+		return errors.New("offline mode requires a cache")
+	}
 	return nil
 }
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// maxTimeEntryHours is the maximum number of hours that can be logged in a single entry
+const maxTimeEntryHours = 24
+
+var (
+	// ErrProjectClosed is returned when logging time on a closed project
+	ErrProjectClosed = errors.New("project is closed")
+	// ErrProjectArchived is returned when logging time on an archived project
+	ErrProjectArchived = errors.New("project is archived")
+)
+
+// NewTimeEntryService creates a new TimeEntryService
+func NewTimeEntryService(s *Service) *TimeEntryService {
+	return &TimeEntryService{s: s}
+}
+
+// TimeEntryService is used for logging member time in Glass Factory
+type TimeEntryService struct {
+	s *Service
+}
+
+// Create logs a new time entry for the member
+func (r *TimeEntryService) Create(userID int, entry *model.TimeEntry) *TimeEntryCreateCall {
+	c := &TimeEntryCreateCall{s: r.s}
+	c.userID = userID
+	c.entry = entry
+	return c
+}
+
+// Update changes an existing time entry of the member
+func (r *TimeEntryService) Update(userID int, entry *model.TimeEntry) *TimeEntryUpdateCall {
+	c := &TimeEntryUpdateCall{s: r.s}
+	c.userID = userID
+	c.entry = entry
+	return c
+}
+
+// Delete removes a time entry of the member
+func (r *TimeEntryService) Delete(userID int, entryID int) *TimeEntryDeleteCall {
+	c := &TimeEntryDeleteCall{s: r.s}
+	c.userID = userID
+	c.entryID = entryID
+	return c
+}
+
+// ValidateTimeEntry returns a *ValidationError if the time entry can't be logged
+func ValidateTimeEntry(entry *model.TimeEntry) error {
+	switch {
+	case entry == nil:
+		return &ValidationError{Field: "entry", Message: "time entry is required"}
+	case !entry.Date.IsValid():
+		return &ValidationError{Field: "date", Message: "date is required"}
+	case entry.ProjectID <= 0:
+		return &ValidationError{Field: "project_id", Message: "project is required"}
+	case entry.Hours <= 0:
+		return &ValidationError{Field: "time", Message: "hours must be greater than zero"}
+	case entry.Hours > maxTimeEntryHours:
+		return &ValidationError{Field: "time", Message: fmt.Sprintf("hours can't be more than %d", maxTimeEntryHours)}
+	}
+	return nil
+}
+
+// validateProject returns an error if time can't be logged on the project. The
+// project is always fetched, as it may have been closed after it was cached.
+func (s *Service) validateProject(ctx context.Context, projectID int) error {
+	project, err := s.Project.GetContext(ctx, projectID, WithCache(false))
+	if err != nil {
+		return err
+	}
+	switch {
+	case project.Archived:
+		return fmt.Errorf("project %d: %w", projectID, ErrProjectArchived)
+	case project.Closed:
+		return fmt.Errorf("project %d: %w", projectID, ErrProjectClosed)
+	}
+	return nil
+}
+
+// timeEntryRequest represents the request body for creating and updating time entries
+type timeEntryRequest struct {
+	TimeEntry *model.TimeEntry `json:"time_entry"`
+}
+
+// TimeEntryResponse represents a response from the Time Entry APIs
+type TimeEntryResponse struct {
+	TimeEntry *model.TimeEntry
+}
+
+// decodeTimeEntryResponse checks the response and decodes the time entry
+func decodeTimeEntryResponse(res *http.Response, entry *model.TimeEntry) (*TimeEntryResponse, error) {
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+	target := *entry
+	if err := DecodeResponse(&target, res); err != nil {
+		return nil, err
+	}
+	ret := &TimeEntryResponse{}
+	ret.TimeEntry = &target
+	return ret, nil
+}
+
+// TimeEntryCreateCall represents a request to Create Time Entry API
+type TimeEntryCreateCall struct {
+	s      *Service
+	ctx    context.Context
+	userID int
+	entry  *model.TimeEntry
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *TimeEntryCreateCall) Context(ctx context.Context) *TimeEntryCreateCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *TimeEntryCreateCall) doRequest() (*http.Response, error) {
+	if c.userID <= 0 {
+		return nil, errors.New("user ID is required")
+	}
+	if err := ValidateTimeEntry(c.entry); err != nil {
+		return nil, err
+	}
+	if err := c.s.validateProject(c.ctx, c.entry.ProjectID); err != nil {
+		return nil, err
+	}
+	urls := c.s.BasePath + fmt.Sprintf("members/%d/time_entries.json", c.userID)
+
+	req, err := c.s.newJSONRequest(c.ctx, http.MethodPost, urls, &timeEntryRequest{TimeEntry: c.entry})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and returns the created time entry
+func (c *TimeEntryCreateCall) Do() (*TimeEntryResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	return decodeTimeEntryResponse(res, c.entry)
+}
+
+// TimeEntryUpdateCall represents a request to Update Time Entry API
+type TimeEntryUpdateCall struct {
+	s      *Service
+	ctx    context.Context
+	userID int
+	entry  *model.TimeEntry
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *TimeEntryUpdateCall) Context(ctx context.Context) *TimeEntryUpdateCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *TimeEntryUpdateCall) doRequest() (*http.Response, error) {
+	if c.userID <= 0 {
+		return nil, errors.New("user ID is required")
+	}
+	if err := ValidateTimeEntry(c.entry); err != nil {
+		return nil, err
+	}
+	if c.entry.ID <= 0 {
+		return nil, &ValidationError{Field: "id", Message: "time entry ID is required"}
+	}
+	if err := c.s.validateProject(c.ctx, c.entry.ProjectID); err != nil {
+		return nil, err
+	}
+	urls := c.s.BasePath + fmt.Sprintf("members/%d/time_entries/%d.json", c.userID, c.entry.ID)
+
+	req, err := c.s.newJSONRequest(c.ctx, http.MethodPut, urls, &timeEntryRequest{TimeEntry: c.entry})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request and returns the updated time entry
+func (c *TimeEntryUpdateCall) Do() (*TimeEntryResponse, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	return decodeTimeEntryResponse(res, c.entry)
+}
+
+// TimeEntryDeleteCall represents a request to Delete Time Entry API
+type TimeEntryDeleteCall struct {
+	s       *Service
+	ctx     context.Context
+	userID  int
+	entryID int
+}
+
+// Context sets the context to be used in this call's Do method
+func (c *TimeEntryDeleteCall) Context(ctx context.Context) *TimeEntryDeleteCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *TimeEntryDeleteCall) doRequest() (*http.Response, error) {
+	if c.userID <= 0 {
+		return nil, errors.New("user ID is required")
+	}
+	if c.entryID <= 0 {
+		return nil, &ValidationError{Field: "id", Message: "time entry ID is required"}
+	}
+	urls := c.s.BasePath + fmt.Sprintf("members/%d/time_entries/%d.json", c.userID, c.entryID)
+
+	req, err := c.s.newRequest(c.ctx, http.MethodDelete, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Do executes the request
+func (c *TimeEntryDeleteCall) Do() error {
+	res, err := c.doRequest()
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return CheckResponse(res)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gopkg.in/h2non/gock.v1"
+	"gotest.tools/assert"
+)
+
+func newTestTimeEntry() *model.TimeEntry {
+	return &model.TimeEntry{
+		Date:       dateutil.DateOf(time.Date(2019, time.September, 2, 0, 0, 0, 0, time.UTC)),
+		ProjectID:  222,
+		ActivityID: 42,
+		Hours:      7.5,
+	}
+}
+
+func TestValidateTimeEntry(t *testing.T) {
+	var tests = []struct {
+		name   string
+		modify func(e *model.TimeEntry)
+		field  string
+	}{
+		{name: "valid", modify: func(e *model.TimeEntry) {}},
+		{name: "missing date", modify: func(e *model.TimeEntry) { e.Date = dateutil.Date{} }, field: "date"},
+		{name: "missing project", modify: func(e *model.TimeEntry) { e.ProjectID = 0 }, field: "project_id"},
+		{name: "zero hours", modify: func(e *model.TimeEntry) { e.Hours = 0 }, field: "time"},
+		{name: "too many hours", modify: func(e *model.TimeEntry) { e.Hours = 24.5 }, field: "time"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry := newTestTimeEntry()
+			test.modify(entry)
+			err := ValidateTimeEntry(entry)
+			if test.field == "" {
+				assert.NilError(t, err)
+				return
+			}
+			var e *ValidationError
+			assert.Assert(t, errors.As(err, &e))
+			assert.Equal(t, e.Field, test.field)
+			assert.Assert(t, IsValidationError(err))
+		})
+	}
+}
+
+func TestCreateTimeEntry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects/222.json").
+		Reply(200).
+		BodyString(`{"id": 222, "name": "Test Project"}`)
+
+	gock.New("https://example.glassfactory.io").
+		Post("/api/public/v1/members/123/time_entries.json").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"time_entry": map[string]interface{}{
+				"date":        "2019-09-02",
+				"project_id":  222,
+				"activity_id": 42,
+				"time":        7.5,
+			},
+		}).
+		Reply(201).
+		BodyString(`{"id": 9, "user_id": 123, "date": "2019-09-02", "project_id": 222, "activity_id": 42, "time": 7.5}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	res, err := s.TimeEntry.Create(123, newTestTimeEntry()).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, res.TimeEntry.ID, 9)
+	assert.Equal(t, res.TimeEntry.UserID, 123)
+	assert.Equal(t, res.TimeEntry.Hours, 7.5)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestCreateTimeEntry_ClosedProject(t *testing.T) {
+	var tests = []struct {
+		name     string
+		project  string
+		expected error
+	}{
+		{name: "closed", project: `{"id": 222, "closed": true}`, expected: ErrProjectClosed},
+		{name: "archived", project: `{"id": 222, "archived": true}`, expected: ErrProjectArchived},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer gock.Off()
+
+			gock.New("https://example.glassfactory.io").
+				Get("/api/public/v1/projects/222.json").
+				Reply(200).
+				BodyString(test.project)
+
+			s, err := NewService(context.Background(), newTestSettings())
+			assert.NilError(t, err)
+
+			_, err = s.TimeEntry.Create(123, newTestTimeEntry()).Do()
+			assert.Assert(t, errors.Is(err, test.expected), "unexpected error: %v", err)
+			assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+		})
+	}
+}
+
+func TestUpdateTimeEntry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects/222.json").
+		Reply(200).
+		BodyString(`{"id": 222, "name": "Test Project"}`)
+
+	gock.New("https://example.glassfactory.io").
+		Put("/api/public/v1/members/123/time_entries/9.json").
+		Reply(422).
+		BodyString(`{"errors": ["time is invalid"]}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	entry := newTestTimeEntry()
+	_, err = s.TimeEntry.Update(123, entry).Do()
+	assert.Assert(t, IsValidationError(err))
+	assert.Error(t, err, "glassfactory: invalid id: time entry ID is required")
+
+	entry.ID = 9
+	_, err = s.TimeEntry.Update(123, entry).Do()
+	assert.Assert(t, IsValidationError(err))
+	var apiErr *Error
+	assert.Assert(t, errors.As(err, &apiErr))
+	assert.Equal(t, apiErr.Message, "time is invalid")
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestDeleteTimeEntry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Delete("/api/public/v1/members/123/time_entries/9.json").
+		Reply(http.StatusNoContent)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	err = s.TimeEntry.Delete(123, 9).Do()
+	assert.NilError(t, err)
+
+	// Verify that we don't have pending mocks
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
+
+func TestCreateTimeEntry_ProjectClosedAfterCaching(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects/222.json").
+		Reply(200).
+		BodyString(`{"id": 222, "name": "Test Project"}`)
+
+	gock.New("https://example.glassfactory.io").
+		Get("/api/public/v1/projects/222.json").
+		Reply(200).
+		BodyString(`{"id": 222, "name": "Test Project", "closed": true}`)
+
+	s, err := NewService(context.Background(), newTestSettings())
+	assert.NilError(t, err)
+
+	project, err := s.Project.Get(222)
+	assert.NilError(t, err)
+	assert.Assert(t, !project.Closed)
+
+	_, err = s.TimeEntry.Create(123, newTestTimeEntry()).Do()
+	assert.Assert(t, errors.Is(err, ErrProjectClosed), "unexpected error: %v", err)
+	assert.Assert(t, gock.IsDone(), "all mocks should have been called")
+}
@@ -6,6 +6,7 @@ type TimeReportOptions struct {
 	projectIDs   []int // Project IDs separated by comma
 	officeID     int   // Office ID
 	fetchRelated bool  // Fetch related data into the results
+	concurrency  int   // Maximum number of concurrent requests
 }
 
 func (options *TimeReportOptions) apply(opts []TimeReportOption) {
@@ -60,10 +61,19 @@ func FetchRelated() TimeReportOption {
 	})
 }
 
+// WithConcurrency runs up to n time report requests in parallel when
+// fetching reports for multiple months
+func WithConcurrency(n int) TimeReportOption {
+	return timeReportOptionFunc(func(o *TimeReportOptions) {
+		o.concurrency = n
+	})
+}
+
 // NewTimeReportOptions returns TimeReportOptions with defaults
 func NewTimeReportOptions(opts []TimeReportOption) *TimeReportOptions {
 	options := &TimeReportOptions{
 		fetchRelated: false, // Do not fetch related data by default
+		concurrency:  1,     // Run requests one after another by default
 	}
 	for _, o := range opts {
 		o.apply(options)
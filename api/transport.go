@@ -2,8 +2,11 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 const publicAPI = "https://%s.glassfactory.io/api/public/v1/"
@@ -13,6 +16,8 @@ type AuthTransport struct {
 	UserEmail        string
 	UserToken        string
 	AccountSubdomain string
+	UserAgent        string            // User-Agent header, the Go default is used if empty
+	Base             http.RoundTripper // Transport used for the requests, defaults to http.DefaultTransport
 }
 
 // RoundTrip authorizes the request with HTTP Basic Auth
@@ -20,19 +25,73 @@ func (trans AuthTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	req.Header.Set("X-User-Email", trans.UserEmail)
 	req.Header.Set("X-User-Token", trans.UserToken)
 	req.Header.Set("X-Account-Subdomain", trans.AccountSubdomain)
+	if trans.UserAgent != "" {
+		req.Header.Set("User-Agent", trans.UserAgent)
+	}
+	if trans.Base != nil {
+		return trans.Base.RoundTrip(req)
+	}
 	return http.DefaultTransport.RoundTrip(req)
 }
 
 // NewClient returns a new HTTP client with Glass Factory authentication
-func NewClient(ctx context.Context, settings *Settings) (*http.Client, string, error) {
+func NewClient(ctx context.Context, settings *Settings, opts ...ClientOption) (*http.Client, string, error) {
 	err := settings.Validate()
 	if err != nil {
 		return nil, "", err
 	}
-	trans := &AuthTransport{
+	options := NewClientOptions(opts)
+	client := &http.Client{}
+	if options.httpClient != nil {
+		*client = *options.httpClient
+	}
+	base := client.Transport
+	if options.transport != nil {
+		base = options.transport
+	}
+	if settings.DebugLog != nil {
+		base = NewDebugTransport(base, settings.DebugLog, settings.DebugJSON)
+	}
+	if options.timeout > 0 {
+		client.Timeout = options.timeout
+	}
+	var trans http.RoundTripper = &AuthTransport{
 		UserEmail:        settings.UserEmail,
 		UserToken:        settings.UserToken,
-		AccountSubdomain: settings.AccountSubdomain}
+		AccountSubdomain: settings.AccountSubdomain,
+		UserAgent:        options.userAgent,
+		Base:             base}
+	if settings.RateLimit > 0 {
+		trans = &RateLimitTransport{
+			Base:    trans,
+			Limiter: NewRateLimiter(settings.RateLimit, settings.RateBurst),
+		}
+	}
+	retry := settings.Retry
+	if retry == nil {
+		retry = DefaultRetryPolicy()
+	}
+	if retry.MaxAttempts > 1 {
+		trans = NewRetryTransport(trans, *retry)
+	}
+	if settings.Cache != nil {
+		ttl := settings.CacheTTL
+		if ttl == nil {
+			ttl = DefaultCacheTTL()
+		}
+		trans = NewCacheTransport(trans, settings.Cache, *ttl, settings.Offline)
+	}
+	client.Transport = trans
 	endpoint := fmt.Sprintf(publicAPI, settings.AccountSubdomain)
-	return &http.Client{Transport: trans}, endpoint, nil
+	if settings.BaseURL != "" {
+		endpoint = strings.TrimSuffix(settings.BaseURL, "/") + "/"
+	}
+	if options.endpoint != "" {
+		u, err := url.Parse(options.endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, "", errors.New("invalid endpoint")
+		}
+		endpoint = strings.TrimSuffix(options.endpoint, "/") + "/"
+	}
+	return client, endpoint, nil
 }
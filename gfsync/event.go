@@ -0,0 +1,65 @@
+package gfsync
+
+import (
+	"context"
+	"fmt"
+)
+
+// EventType describes how a synced item changed
+type EventType int
+
+const (
+	// Added is emitted for items that weren't in the previous sync
+	Added EventType = iota
+	// Changed is emitted for items whose content changed since the previous sync
+	Changed
+	// Removed is emitted for items that no longer exist in Glass Factory
+	Removed
+)
+
+// String returns the event type name
+func (t EventType) String() string {
+	switch t {
+	case Added:
+		return "added"
+	case Changed:
+		return "changed"
+	case Removed:
+		return "removed"
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (t EventType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Resource types emitted in the events
+const (
+	Clients     = "clients"
+	Members     = "members"
+	Projects    = "projects"
+	TimeReports = "time_reports"
+)
+
+// Event describes a change in a synced item
+type Event struct {
+	Type     EventType   `json:"type"`
+	Resource string      `json:"resource"`        // Resource type, e.g. Projects
+	Key      string      `json:"key"`             // Key of the item within the resource
+	Value    interface{} `json:"value,omitempty"` // Current value of the item, nil for removed items
+}
+
+// Handler receives the sync events
+type Handler interface {
+	HandleEvent(ctx context.Context, e *Event) error
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as event handlers
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// HandleEvent calls f(ctx, e)
+func (f HandlerFunc) HandleEvent(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
@@ -0,0 +1,2 @@
+// Package gfsync provides incremental synchronisation of Glass Factory data
+package gfsync
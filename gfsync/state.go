@@ -0,0 +1,85 @@
+package gfsync
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State contains the last sync point and the synced items of each resource
+type State struct {
+	Resources map[string]*ResourceState `json:"resources"`
+}
+
+// ResourceState contains the last sync point and content hashes of the synced items
+type ResourceState struct {
+	LastSync time.Time         `json:"last_sync"`
+	Items    map[string]string `json:"items"` // Content hashes by item key
+}
+
+// NewState creates a new empty State
+func NewState() *State {
+	return &State{
+		Resources: make(map[string]*ResourceState),
+	}
+}
+
+// LoadState reads the state from a file. A missing file returns an empty state.
+func LoadState(path string) (*State, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := NewState()
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	if state.Resources == nil {
+		state.Resources = make(map[string]*ResourceState)
+	}
+	return state, nil
+}
+
+// Save writes the state into a file
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Resource returns the state of the resource, creating it if needed
+func (s *State) Resource(name string) *ResourceState {
+	rs, ok := s.Resources[name]
+	if !ok {
+		rs = &ResourceState{}
+		s.Resources[name] = rs
+	}
+	if rs.Items == nil {
+		rs.Items = make(map[string]string)
+	}
+	return rs
+}
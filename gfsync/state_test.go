@@ -0,0 +1,34 @@
+package gfsync
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "glassfactory-sync")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state", "sync.json")
+
+	state, err := LoadState(path)
+	assert.NilError(t, err)
+	assert.Equal(t, len(state.Resources), 0)
+
+	synced := time.Date(2020, time.February, 15, 12, 0, 0, 0, time.UTC)
+	rs := state.Resource(Projects)
+	rs.LastSync = synced
+	rs.Items["200"] = "abc"
+	assert.NilError(t, state.Save(path))
+
+	loaded, err := LoadState(path)
+	assert.NilError(t, err)
+	assert.Assert(t, loaded.Resource(Projects).LastSync.Equal(synced))
+	assert.Equal(t, loaded.Resource(Projects).Items["200"], "abc")
+	assert.Equal(t, len(loaded.Resource(Clients).Items), 0)
+}
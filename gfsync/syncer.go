@@ -0,0 +1,283 @@
+package gfsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/101loops/clock"
+	"github.com/jinzhu/now"
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// DefaultLookback is the number of months after the end of a month during which
+// its time reports are still fetched again, as time can be logged retroactively
+const DefaultLookback = 1
+
+// Option configures the Syncer
+type Option interface {
+	apply(*Syncer)
+}
+
+type optionFunc func(*Syncer)
+
+func (f optionFunc) apply(s *Syncer) {
+	f(s)
+}
+
+// WithLookback sets the number of months after the end of a month during which
+// its time reports are fetched again
+func WithLookback(months int) Option {
+	return optionFunc(func(s *Syncer) {
+		s.lookback = months
+	})
+}
+
+// WithFullSync fetches all clients, members and projects instead of only the
+// ones updated since the last sync. Removed items are detected only on full syncs.
+func WithFullSync() Option {
+	return optionFunc(func(s *Syncer) {
+		s.full = true
+	})
+}
+
+// Syncer fetches changes in Glass Factory since the last sync and emits
+// them to the handler
+type Syncer struct {
+	s        *api.Service
+	state    *State
+	handler  Handler
+	lookback int
+	full     bool
+	clock    clock.Clock
+}
+
+// NewSyncer creates a new Syncer using the given state
+func NewSyncer(s *api.Service, state *State, handler Handler, opts ...Option) (*Syncer, error) {
+	if s == nil {
+		return nil, errors.New("api service is nil")
+	}
+	if handler == nil {
+		return nil, errors.New("handler is nil")
+	}
+	if state == nil {
+		state = NewState()
+	}
+	sy := &Syncer{
+		s:        s,
+		state:    state,
+		handler:  handler,
+		lookback: DefaultLookback,
+		clock:    clock.New(),
+	}
+	for _, o := range opts {
+		o.apply(sy)
+	}
+	return sy, nil
+}
+
+// State returns the sync state to be saved after syncing
+func (sy *Syncer) State() *State {
+	return sy.state
+}
+
+// Sync synchronises clients, members, projects and the time reports of the
+// synced members since the start date
+func (sy *Syncer) Sync(ctx context.Context, start time.Time) error {
+	if err := sy.SyncClients(ctx); err != nil {
+		return err
+	}
+	if err := sy.SyncMembers(ctx); err != nil {
+		return err
+	}
+	if err := sy.SyncProjects(ctx); err != nil {
+		return err
+	}
+	userIDs := make([]int, 0)
+	for key := range sy.state.Resource(Members).Items {
+		if userID, err := strconv.Atoi(key); err == nil {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Ints(userIDs)
+	return sy.SyncTimeReports(ctx, userIDs, start)
+}
+
+// SyncClients synchronises clients updated since the last sync
+func (sy *Syncer) SyncClients(ctx context.Context) error {
+	return sy.syncResource(ctx, Clients, func(opts []api.RequestOption) (map[string]interface{}, error) {
+		clients, err := sy.s.Client.AllContext(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]interface{}, len(clients))
+		for _, c := range clients {
+			items[strconv.Itoa(c.ID)] = c
+		}
+		return items, nil
+	})
+}
+
+// SyncMembers synchronises members updated since the last sync
+func (sy *Syncer) SyncMembers(ctx context.Context) error {
+	return sy.syncResource(ctx, Members, func(opts []api.RequestOption) (map[string]interface{}, error) {
+		members, err := sy.s.Member.AllContext(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]interface{}, len(members))
+		for _, m := range members {
+			items[strconv.Itoa(m.ID)] = m
+		}
+		return items, nil
+	})
+}
+
+// SyncProjects synchronises projects updated since the last sync
+func (sy *Syncer) SyncProjects(ctx context.Context) error {
+	return sy.syncResource(ctx, Projects, func(opts []api.RequestOption) (map[string]interface{}, error) {
+		projects, err := sy.s.Project.AllContext(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]interface{}, len(projects))
+		for _, p := range projects {
+			items[strconv.Itoa(p.ID)] = p
+		}
+		return items, nil
+	})
+}
+
+// syncResource fetches the items of a resource and emits events for the changes
+func (sy *Syncer) syncResource(ctx context.Context, name string, fetch func(opts []api.RequestOption) (map[string]interface{}, error)) error {
+	rs := sy.state.Resource(name)
+	started := sy.clock.Now()
+	full := sy.full || rs.LastSync.IsZero()
+	opts := []api.RequestOption{api.WithCache(false)}
+	if !full {
+		opts = append(opts, api.WithUpdatedSince(rs.LastSync))
+	}
+	items, err := fetch(opts)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if err := sy.apply(ctx, name, rs, items, full); err != nil {
+		return err
+	}
+	rs.LastSync = started
+	return nil
+}
+
+// SyncTimeReports synchronises the time reports of the members by month since
+// the start date. Months that were synced more than the lookback period after
+// they ended aren't fetched again.
+func (sy *Syncer) SyncTimeReports(ctx context.Context, userIDs []int, start time.Time) error {
+	started := sy.clock.Now()
+	for _, userID := range userIDs {
+		for _, m := range dateutil.MonthsBetweenDates(start, started) {
+			name := fmt.Sprintf("%s/%d/%s", TimeReports, userID, m.Start.Format("2006-01"))
+			rs := sy.state.Resource(name)
+			settled := now.With(m.Start).EndOfMonth().AddDate(0, sy.lookback, 0)
+			if !rs.LastSync.IsZero() && rs.LastSync.After(settled) {
+				continue
+			}
+			if m.Start.Before(start) {
+				m.Start = start
+			}
+			if m.End.After(started) {
+				m.End = started
+			}
+			res, err := sy.s.Member.Reports.TimeReport(userID, m.Start, m.End).Context(ctx).Do()
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			if err := sy.apply(ctx, TimeReports, rs, timeReportItems(res.Reports), true); err != nil {
+				return err
+			}
+			rs.LastSync = started
+		}
+	}
+	return nil
+}
+
+// timeReportItems returns the time reports by their keys
+func timeReportItems(reports []*model.MemberTimeReport) map[string]interface{} {
+	items := make(map[string]interface{}, len(reports))
+	for _, r := range reports {
+		key := fmt.Sprintf("%d/%s/%d/%d/%d", r.UserID, r.Date, r.ProjectID, r.ActivityID, r.RoleID)
+		// Multiple reports with the same key are numbered in the order they were returned
+		for i, k := 1, key; ; i++ {
+			if _, ok := items[k]; !ok {
+				key = k
+				break
+			}
+			k = fmt.Sprintf("%s/%d", key, i)
+		}
+		items[key] = r
+	}
+	return items
+}
+
+// apply compares the items to the resource state, emits events for the changes
+// and updates the state. Removed items are only detected when the items are complete.
+func (sy *Syncer) apply(ctx context.Context, resource string, rs *ResourceState, items map[string]interface{}, complete bool) error {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := items[key]
+		hash, err := contentHash(value)
+		if err != nil {
+			return err
+		}
+		previous, ok := rs.Items[key]
+		if ok && previous == hash {
+			continue
+		}
+		e := &Event{Type: Added, Resource: resource, Key: key, Value: value}
+		if ok {
+			e.Type = Changed
+		}
+		if err := sy.handler.HandleEvent(ctx, e); err != nil {
+			return err
+		}
+		rs.Items[key] = hash
+	}
+	if !complete {
+		return nil
+	}
+	removed := make([]string, 0)
+	for key := range rs.Items {
+		if _, ok := items[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		if err := sy.handler.HandleEvent(ctx, &Event{Type: Removed, Resource: resource, Key: key}); err != nil {
+			return err
+		}
+		delete(rs.Items, key)
+	}
+	return nil
+}
+
+// contentHash returns a hash of the JSON encoded value
+func contentHash(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,121 @@
+package gfsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/101loops/clock"
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/gftest"
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+// eventRecorder records the received events
+type eventRecorder struct {
+	events []*Event
+}
+
+func (r *eventRecorder) HandleEvent(ctx context.Context, e *Event) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+// count returns the number of recorded events of the type for the resource
+func (r *eventRecorder) count(resource string, t EventType) int {
+	n := 0
+	for _, e := range r.events {
+		if e.Resource == resource && e.Type == t {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSyncer(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)
+	ds := gftest.SampleDataset(start, end)
+	srv := gftest.NewServerWithDataset(ds)
+	defer srv.Close()
+
+	ctx := context.Background()
+	s, err := api.NewService(ctx, srv.Settings())
+	assert.NilError(t, err)
+
+	today := time.Date(2020, time.February, 14, 12, 0, 0, 0, time.UTC)
+	mock := clock.NewMock().FreezeAt(today)
+	events := &eventRecorder{}
+	sy, err := NewSyncer(s, nil, events)
+	assert.NilError(t, err)
+	sy.clock = mock
+
+	t.Run("first sync adds everything", func(t *testing.T) {
+		assert.NilError(t, sy.Sync(ctx, start))
+		assert.Equal(t, events.count(Clients, Added), 3)
+		assert.Equal(t, events.count(Members, Added), 3)
+		assert.Equal(t, events.count(Projects, Added), 5)
+		// 33 working days until 14 February with two reports each for two members
+		assert.Equal(t, events.count(TimeReports, Added), 132)
+		assert.Equal(t, len(events.events), 143)
+	})
+
+	t.Run("unchanged data emits no events", func(t *testing.T) {
+		events.events = nil
+		mock.Add(24 * time.Hour)
+		assert.NilError(t, sy.Sync(ctx, start))
+		assert.Equal(t, len(events.events), 0)
+		assert.Equal(t, srv.Requests()[len(srv.Requests())-1].Query.Get("start"), "2020-02-01")
+	})
+
+	t.Run("changes since the last sync", func(t *testing.T) {
+		events.events = nil
+		mock.Add(24 * time.Hour)
+		project := *ds.Projects[1]
+		project.Name = "Mobile App v2"
+		project.UpdatedAt = dateutil.DateTimeOf(mock.Now().Add(-time.Hour))
+		srv.AddProjects(&project)
+		_, err := s.TimeEntry.Create(100, &model.TimeEntry{
+			Date:      dateutil.DateOf(today),
+			ProjectID: 202,
+			Hours:     1,
+		}).Do()
+		assert.NilError(t, err)
+
+		assert.NilError(t, sy.Sync(ctx, start))
+		assert.Equal(t, len(events.events), 2)
+		assert.Equal(t, events.events[0].Type, Changed)
+		assert.Equal(t, events.events[0].Resource, Projects)
+		assert.Equal(t, events.events[0].Key, "201")
+		assert.Equal(t, events.events[0].Value.(*model.Project).Name, "Mobile App v2")
+		assert.Equal(t, events.events[1].Type, Added)
+		assert.Equal(t, events.events[1].Key, "100/2020-02-14/202/0/1000")
+	})
+
+	t.Run("full sync detects removed items", func(t *testing.T) {
+		events.events = nil
+		sy.state.Resource(Clients).Items["999"] = "removed"
+		full, err := NewSyncer(s, sy.State(), events, WithFullSync())
+		assert.NilError(t, err)
+		full.clock = mock
+		assert.NilError(t, full.SyncClients(ctx))
+		assert.Equal(t, len(events.events), 1)
+		assert.Equal(t, events.events[0].Type, Removed)
+		assert.Equal(t, events.events[0].Key, "999")
+		_, ok := sy.State().Resource(Clients).Items["999"]
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("settled months are not fetched again", func(t *testing.T) {
+		mock.Set(time.Date(2020, time.April, 15, 0, 0, 0, 0, time.UTC))
+		assert.NilError(t, sy.SyncTimeReports(ctx, []int{100}, start))
+		srv.ResetRequests()
+		assert.NilError(t, sy.SyncTimeReports(ctx, []int{100}, start))
+		for _, req := range srv.Requests() {
+			assert.Assert(t, req.Query.Get("start") >= "2020-03-01", req.Query.Encode())
+		}
+		assert.Equal(t, srv.RequestCount("members/100/reports/time.json"), 2)
+	})
+}
@@ -0,0 +1,93 @@
+package gftest
+
+import (
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// Dataset contains the data served by the Server
+type Dataset struct {
+	Clients     []*model.Client
+	Members     []*model.Member
+	Projects    []*model.Project
+	Offices     []*model.Office
+	Roles       []*model.Role
+	Activities  []*model.Activity
+	TimeReports []*model.MemberTimeReport
+	Allocations []*model.Allocation
+}
+
+// SampleDataset returns a small dataset with an office, a few roles, activities,
+// clients, projects and members, daily time reports for each working day
+// between the given dates and allocations covering the whole period
+func SampleDataset(start time.Time, end time.Time) *Dataset {
+	ds := &Dataset{
+		Clients: []*model.Client{
+			{ID: 1, Name: "ACME Inc.", OfficeID: 10},
+			{ID: 2, Name: "Globex Corporation", OfficeID: 10},
+			{ID: 3, Name: "Internal", OfficeID: 10},
+		},
+		Members: []*model.Member{
+			{ID: 100, Name: "First Member", Email: "first@example.com", RoleID: 1000, Capacity: 8, OfficeID: 10},
+			{ID: 101, Name: "Second Member", Email: "second@example.com", RoleID: 1001, Capacity: 7.5, OfficeID: 10},
+			{ID: 102, Name: "Archived Member", Email: "archived@example.com", RoleID: 1000, Capacity: 8, OfficeID: 10, Archived: true},
+		},
+		Offices: []*model.Office{
+			{ID: 10, Name: "London"},
+		},
+		Roles: []*model.Role{
+			{ID: 1000, Name: "Designer", Rate: 90, Currency: "GBP"},
+			{ID: 1001, Name: "Developer", Rate: 100, Currency: "GBP"},
+		},
+		Activities: []*model.Activity{
+			{ID: 1, Name: "Project Work"},
+			{ID: 2, Name: "Meetings"},
+		},
+		Projects: []*model.Project{
+			{ID: 200, Name: "Website Redesign", ClientID: 1, OfficeID: 10, ManagerID: 100, BillableStatus: model.Billable},
+			{ID: 201, Name: "Mobile App", ClientID: 2, OfficeID: 10, ManagerID: 101, BillableStatus: model.Billable},
+			{ID: 202, Name: "Pitch", ClientID: 2, OfficeID: 10, ManagerID: 100, BillableStatus: model.NewBusiness},
+			{ID: 203, Name: "Admin", ClientID: 3, OfficeID: 10, ManagerID: 100, BillableStatus: model.NonBillable},
+			{ID: 204, Name: "Old Website", ClientID: 1, OfficeID: 10, ManagerID: 100, BillableStatus: model.Billable, Closed: true},
+		},
+	}
+	ds.Allocations = []*model.Allocation{
+		{ID: 300, UserID: 100, ProjectID: 200, RoleID: 1000, StartDate: dateutil.DateOf(start), EndDate: dateutil.DateOf(end), Hours: 6},
+		{ID: 301, UserID: 101, ProjectID: 201, RoleID: 1001, StartDate: dateutil.DateOf(start), EndDate: dateutil.DateOf(end), Hours: 6},
+		{ID: 302, UserID: 100, ProjectID: 202, RoleID: 1000, StartDate: dateutil.DateOf(start), EndDate: dateutil.DateOf(end), Hours: 2, Tentative: true},
+	}
+	members := []*model.Member{ds.Members[0], ds.Members[1]}
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			continue
+		}
+		for i, m := range members {
+			billable := ds.Projects[(d.Day()+i)%2]
+			ds.TimeReports = append(ds.TimeReports,
+				&model.MemberTimeReport{
+					UserID:     m.ID,
+					Date:       dateutil.DateOf(d),
+					ClientID:   billable.ClientID,
+					ProjectID:  billable.ID,
+					RoleID:     m.RoleID,
+					ActivityID: 1,
+					Planned:    6,
+					Actual:     5.5,
+				},
+				&model.MemberTimeReport{
+					UserID:     m.ID,
+					Date:       dateutil.DateOf(d),
+					ClientID:   3,
+					ProjectID:  203,
+					RoleID:     m.RoleID,
+					ActivityID: 2,
+					Planned:    2,
+					Actual:     2.5,
+				},
+			)
+		}
+	}
+	return ds
+}
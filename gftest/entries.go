@@ -0,0 +1,130 @@
+package gftest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// timeEntryRequest represents the request body for creating and updating time entries
+type timeEntryRequest struct {
+	TimeEntry *model.TimeEntry `json:"time_entry"`
+}
+
+// TimeEntries returns the time entries logged on the server
+func (s *Server) TimeEntries() []*model.TimeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := make([]*model.TimeEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entry := *e
+		entries = append(entries, &entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return entries
+}
+
+// timeEntryReports returns the logged time entries as time reports.
+// Must be called while holding the server lock.
+func (s *Server) timeEntryReports() []*model.MemberTimeReport {
+	reports := make([]*model.MemberTimeReport, 0, len(s.entries))
+	for _, e := range s.entries {
+		tr := &model.MemberTimeReport{
+			UserID:     e.UserID,
+			Date:       e.Date,
+			ProjectID:  e.ProjectID,
+			ActivityID: e.ActivityID,
+			Actual:     e.Hours,
+		}
+		if p, ok := s.projects[e.ProjectID]; ok {
+			tr.ClientID = p.ClientID
+			tr.JobID = p.JobID
+		}
+		if m, ok := s.members[e.UserID]; ok {
+			tr.RoleID = m.RoleID
+		}
+		reports = append(reports, tr)
+	}
+	return reports
+}
+
+// decodeTimeEntry decodes and validates the time entry in the request body.
+// Must be called while holding the server lock.
+func (s *Server) decodeTimeEntry(w http.ResponseWriter, r *http.Request, userID int) (*model.TimeEntry, bool) {
+	if _, ok := s.members[userID]; !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	var body timeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.TimeEntry == nil {
+		writeError(w, http.StatusBadRequest, `{"error": "time_entry is required"}`)
+		return nil, false
+	}
+	entry := body.TimeEntry
+	if !entry.Date.IsValid() || entry.Hours <= 0 {
+		writeError(w, http.StatusUnprocessableEntity, `{"errors": ["date and time are required"]}`)
+		return nil, false
+	}
+	p, ok := s.projects[entry.ProjectID]
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, `{"errors": ["project not found"]}`)
+		return nil, false
+	}
+	if p.Closed || p.Archived {
+		writeError(w, http.StatusUnprocessableEntity, `{"errors": ["project is closed"]}`)
+		return nil, false
+	}
+	entry.UserID = userID
+	return entry, true
+}
+
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request, args []string) {
+	userID, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	entry, ok := s.decodeTimeEntry(w, r, userID)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	s.lastEntry++
+	entry.ID = s.lastEntry
+	s.entries[entry.ID] = entry
+	s.mu.Unlock()
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+func (s *Server) updateTimeEntry(w http.ResponseWriter, r *http.Request, args []string) {
+	userID, _ := strconv.Atoi(args[0])
+	entryID, _ := strconv.Atoi(args[1])
+	s.mu.Lock()
+	if existing, ok := s.entries[entryID]; !ok || existing.UserID != userID {
+		s.mu.Unlock()
+		writeNotFound(w)
+		return
+	}
+	entry, ok := s.decodeTimeEntry(w, r, userID)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	entry.ID = entryID
+	s.entries[entryID] = entry
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request, args []string) {
+	userID, _ := strconv.Atoi(args[0])
+	entryID, _ := strconv.Atoi(args[1])
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.entries[entryID]; !ok || existing.UserID != userID {
+		writeNotFound(w)
+		return
+	}
+	delete(s.entries, entryID)
+	w.WriteHeader(http.StatusNoContent)
+}
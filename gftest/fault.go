@@ -0,0 +1,31 @@
+package gftest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault is injected into matching requests to simulate slow or failing responses
+type Fault struct {
+	Method  string        // Request method to match, empty matches all methods
+	Path    string        // Path prefix relative to the API root to match, empty matches all paths
+	Latency time.Duration // Delay before responding
+	Status  int           // Response status code, zero continues with the normal response
+	Body    string        // Response body returned with the status code
+	Header  http.Header   // Additional response headers
+	Times   int           // Number of requests the fault applies to, zero applies to all
+
+	hits int
+}
+
+func (f *Fault) matches(method string, path string) bool {
+	if f.Method != "" && f.Method != method {
+		return false
+	}
+	return strings.HasPrefix(path, f.Path)
+}
+
+func (f *Fault) exhausted() bool {
+	return f.Times > 0 && f.hits >= f.Times
+}
@@ -0,0 +1,80 @@
+package gftest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// listFilter matches clients, members and projects using the list query parameters
+type listFilter struct {
+	officeID     int
+	managerID    int
+	clientID     int
+	archived     *bool
+	closed       *bool
+	updatedSince time.Time
+}
+
+func parseListFilter(r *http.Request) (*listFilter, error) {
+	query := r.URL.Query()
+	f := &listFilter{}
+	ids := map[string]*int{
+		"office_id":  &f.officeID,
+		"manager_id": &f.managerID,
+		"client_id":  &f.clientID,
+	}
+	for key, target := range ids {
+		if value := query.Get(key); value != "" {
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s", key)
+			}
+			*target = id
+		}
+	}
+	flags := map[string]**bool{
+		"archived": &f.archived,
+		"closed":   &f.closed,
+	}
+	for key, target := range flags {
+		if value := query.Get(key); value != "" {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s", key)
+			}
+			*target = &b
+		}
+	}
+	if value := query.Get("updated_since"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid updated_since")
+		}
+		f.updatedSince = t
+	}
+	return f, nil
+}
+
+// matches reports whether a resource with the given attributes matches the filter.
+// Zero manager and client IDs match any value.
+func (f *listFilter) matches(officeID, managerID, clientID int, archived, closed bool, updatedAt dateutil.DateTime) bool {
+	switch {
+	case f.officeID > 0 && officeID != f.officeID:
+		return false
+	case f.managerID > 0 && managerID != 0 && managerID != f.managerID:
+		return false
+	case f.clientID > 0 && clientID != 0 && clientID != f.clientID:
+		return false
+	case f.archived != nil && archived != *f.archived:
+		return false
+	case f.closed != nil && closed != *f.closed:
+		return false
+	case !f.updatedSince.IsZero() && !updatedAt.In(time.UTC).After(f.updatedSince):
+		return false
+	}
+	return true
+}
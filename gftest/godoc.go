@@ -0,0 +1,2 @@
+// Package gftest provides a fake Glass Factory API server for testing
+package gftest
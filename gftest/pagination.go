@@ -0,0 +1,50 @@
+package gftest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// paginate returns the requested page of the results and sets a Link header
+// pointing to the first page and the next page when there are more results
+func paginate[T any](s *Server, w http.ResponseWriter, r *http.Request, items []T) []T {
+	query := r.URL.Query()
+	s.mu.Lock()
+	perPage := s.pageSize
+	s.mu.Unlock()
+	if n, err := strconv.Atoi(query.Get("per_page")); err == nil && n > 0 {
+		perPage = n
+	}
+	if perPage <= 0 {
+		return items
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	links := []string{s.pageLink(r, 1, "first")}
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		w.Header().Set("Link", strings.Join(links, ", "))
+		return items[:0]
+	}
+	end := start + perPage
+	if end < len(items) {
+		links = append(links, s.pageLink(r, page+1, "next"))
+	} else {
+		end = len(items)
+	}
+	w.Header().Set("Link", strings.Join(links, ", "))
+	return items[start:end]
+}
+
+// pageLink returns a Link header value for the page of the request
+func (s *Server) pageLink(r *http.Request, page int, rel string) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	u := *r.URL
+	u.RawQuery = query.Encode()
+	return fmt.Sprintf(`<%s%s>; rel="%s"`, s.URL, u.RequestURI(), rel)
+}
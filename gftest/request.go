@@ -0,0 +1,65 @@
+package gftest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Request is a request received by the Server
+type Request struct {
+	Method string
+	Path   string // Path relative to the API root, e.g. "clients.json"
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// TestingT is the subset of testing.TB used for request assertions
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Requests returns all requests received by the server
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+// RequestCount returns the number of requests received for paths matching the prefix
+func (s *Server) RequestCount(prefix string) int {
+	count := 0
+	for _, r := range s.Requests() {
+		if strings.HasPrefix(r.Path, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+// ResetRequests clears the recorded requests
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// AssertRequested fails the test unless the server received exactly n requests
+// for paths matching the prefix
+func (s *Server) AssertRequested(t TestingT, prefix string, n int) {
+	t.Helper()
+	if count := s.RequestCount(prefix); count != n {
+		t.Errorf("expected %d requests to %s, got %d", n, prefix, count)
+	}
+}
+
+// AssertNotRequested fails the test if the server received any requests for
+// paths matching the prefix
+func (s *Server) AssertNotRequested(t TestingT, prefix string) {
+	t.Helper()
+	s.AssertRequested(t, prefix, 0)
+}
@@ -0,0 +1,640 @@
+package gftest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// APIPath is the path of the API root on the Server
+const APIPath = "/api/public/v1/"
+
+// Credentials accepted by the Server
+const (
+	UserEmail        = "test@example.com"
+	UserToken        = "gftest-token"
+	AccountSubdomain = "example"
+)
+
+// Server is a fake Glass Factory API server serving data from memory
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	clients     map[int]*model.Client
+	members     map[int]*model.Member
+	projects    map[int]*model.Project
+	offices     map[int]*model.Office
+	roles       map[int]*model.Role
+	activities  map[int]*model.Activity
+	allocations map[int]*model.Allocation
+	entries     map[int]*model.TimeEntry
+	lastEntry   int
+	reports     []*model.MemberTimeReport
+	faults      []*Fault
+	latency     time.Duration
+	pageSize    int
+	requests    []Request
+	routes      []route
+}
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler func(w http.ResponseWriter, r *http.Request, args []string)
+}
+
+// NewServer starts a new Server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		clients:     make(map[int]*model.Client),
+		members:     make(map[int]*model.Member),
+		projects:    make(map[int]*model.Project),
+		offices:     make(map[int]*model.Office),
+		roles:       make(map[int]*model.Role),
+		activities:  make(map[int]*model.Activity),
+		allocations: make(map[int]*model.Allocation),
+		entries:     make(map[int]*model.TimeEntry),
+	}
+	s.handle(http.MethodGet, `clients\.json`, s.listClients)
+	s.handle(http.MethodGet, `clients/(\d+)\.json`, s.getClient)
+	s.handle(http.MethodGet, `members(/active|/archived)?\.json`, s.listMembers)
+	s.handle(http.MethodGet, `members/(\d+)\.json`, s.getMember)
+	s.handle(http.MethodGet, `members/(\d+)/reports/time\.json`, s.memberTimeReports)
+	s.handle(http.MethodPost, `members/(\d+)/time_entries\.json`, s.createTimeEntry)
+	s.handle(http.MethodPut, `members/(\d+)/time_entries/(\d+)\.json`, s.updateTimeEntry)
+	s.handle(http.MethodDelete, `members/(\d+)/time_entries/(\d+)\.json`, s.deleteTimeEntry)
+	s.handle(http.MethodGet, `projects\.json`, s.listProjects)
+	s.handle(http.MethodGet, `projects/(\d+)\.json`, s.getProject)
+	s.handle(http.MethodGet, `offices\.json`, s.listOffices)
+	s.handle(http.MethodGet, `offices/(\d+)\.json`, s.getOffice)
+	s.handle(http.MethodGet, `roles\.json`, s.listRoles)
+	s.handle(http.MethodGet, `roles/(\d+)\.json`, s.getRole)
+	s.handle(http.MethodGet, `activities\.json`, s.listActivities)
+	s.handle(http.MethodGet, `activities/(\d+)\.json`, s.getActivity)
+	s.handle(http.MethodGet, `allocations\.json`, s.listAllocations)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewServerWithDataset starts a new Server seeded with the dataset
+func NewServerWithDataset(ds *Dataset) *Server {
+	s := NewServer()
+	s.Seed(ds)
+	return s
+}
+
+func (s *Server) handle(method string, pattern string, handler func(w http.ResponseWriter, r *http.Request, args []string)) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: regexp.MustCompile("^" + pattern + "$"),
+		handler: handler,
+	})
+}
+
+// BaseURL returns the API base URL of the server
+func (s *Server) BaseURL() string {
+	return s.URL + APIPath
+}
+
+// Settings returns API settings for connecting to the server
+func (s *Server) Settings() *api.Settings {
+	return &api.Settings{
+		UserEmail:        UserEmail,
+		UserToken:        UserToken,
+		AccountSubdomain: AccountSubdomain,
+		BaseURL:          s.BaseURL(),
+		Retry:            &api.RetryPolicy{MaxAttempts: 1},
+	}
+}
+
+// Seed adds the data in the dataset to the server
+func (s *Server) Seed(ds *Dataset) {
+	s.AddClients(ds.Clients...)
+	s.AddMembers(ds.Members...)
+	s.AddProjects(ds.Projects...)
+	s.AddOffices(ds.Offices...)
+	s.AddRoles(ds.Roles...)
+	s.AddActivities(ds.Activities...)
+	s.AddAllocations(ds.Allocations...)
+	s.AddTimeReports(ds.TimeReports...)
+}
+
+// AddClients adds or replaces clients on the server
+func (s *Server) AddClients(clients ...*model.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range clients {
+		s.clients[c.ID] = c
+	}
+}
+
+// AddMembers adds or replaces members on the server
+func (s *Server) AddMembers(members ...*model.Member) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, m := range members {
+		s.members[m.ID] = m
+	}
+}
+
+// AddProjects adds or replaces projects on the server
+func (s *Server) AddProjects(projects ...*model.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range projects {
+		s.projects[p.ID] = p
+	}
+}
+
+// AddOffices adds or replaces offices on the server
+func (s *Server) AddOffices(offices ...*model.Office) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range offices {
+		s.offices[o.ID] = o
+	}
+}
+
+// AddRoles adds or replaces roles on the server
+func (s *Server) AddRoles(roles ...*model.Role) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range roles {
+		s.roles[r.ID] = r
+	}
+}
+
+// AddActivities adds or replaces activities on the server
+func (s *Server) AddActivities(activities ...*model.Activity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range activities {
+		s.activities[a.ID] = a
+	}
+}
+
+// AddAllocations adds or replaces allocations on the server
+func (s *Server) AddAllocations(allocations ...*model.Allocation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range allocations {
+		s.allocations[a.ID] = a
+	}
+}
+
+// AddTimeReports adds time reports on the server
+func (s *Server) AddTimeReports(reports ...*model.MemberTimeReport) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reports = append(s.reports, reports...)
+}
+
+// AddFault injects a fault into the matching requests
+func (s *Server) AddFault(f *Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, f)
+}
+
+// FailNext makes the next n requests to paths matching the prefix fail with the status code
+func (s *Server) FailNext(prefix string, status int, n int) {
+	s.AddFault(&Fault{Path: prefix, Status: status, Times: n})
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// SetLatency delays all responses by the given duration
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// SetPageSize limits the number of clients, members and projects returned per page
+// when the request doesn't set per_page. Zero returns all results.
+func (s *Server) SetPageSize(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize = n
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, APIPath)
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	latency := s.latency
+	var fault *Fault
+	for _, f := range s.faults {
+		if f.matches(r.Method, path) && !f.exhausted() {
+			f.hits++
+			fault = f
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if fault != nil {
+		latency += fault.Latency
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if fault != nil && fault.Status != 0 {
+		for k, v := range fault.Header {
+			w.Header()[k] = v
+		}
+		writeError(w, fault.Status, fault.Body)
+		return
+	}
+
+	if r.Header.Get("X-User-Email") != UserEmail ||
+		r.Header.Get("X-User-Token") != UserToken ||
+		r.Header.Get("X-Account-Subdomain") != AccountSubdomain {
+		writeError(w, http.StatusUnauthorized, `{"error": "You need to sign in or sign up before continuing."}`)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, APIPath) {
+		writeError(w, http.StatusNotFound, `{"error": "Not found"}`)
+		return
+	}
+
+	matched := false
+	for _, rt := range s.routes {
+		args := rt.pattern.FindStringSubmatch(path)
+		if args == nil {
+			continue
+		}
+		matched = true
+		if rt.method == r.Method {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			rt.handler(w, r, args[1:])
+			return
+		}
+	}
+	if matched {
+		writeError(w, http.StatusMethodNotAllowed, `{"error": "Method not allowed"}`)
+		return
+	}
+	writeError(w, http.StatusNotFound, `{"error": "Not found"}`)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, body string) {
+	if body == "" {
+		body = fmt.Sprintf(`{"error": "%s"}`, http.StatusText(status))
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, `{"error": "Record not found"}`)
+}
+
+func matchesTerm(term string, values ...string) bool {
+	if term == "" {
+		return true
+	}
+	term = strings.ToLower(term)
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listClients(w http.ResponseWriter, r *http.Request, args []string) {
+	term := r.URL.Query().Get("term")
+	filter, err := parseListFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(`{"error": "%s"}`, err))
+		return
+	}
+	s.mu.Lock()
+	clients := make([]*model.Client, 0, len(s.clients))
+	for _, c := range s.clients {
+		if matchesTerm(term, c.Name) && filter.matches(c.OfficeID, 0, c.ID, c.IsArchived(), false, c.UpdatedAt) {
+			clients = append(clients, c)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(clients, func(i, j int) bool { return clients[i].ID < clients[j].ID })
+	writeJSON(w, http.StatusOK, paginate(s, w, r, clients))
+}
+
+func (s *Server) getClient(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	c, ok := s.clients[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request, args []string) {
+	status := strings.TrimPrefix(args[0], "/")
+	term := r.URL.Query().Get("term")
+	filter, err := parseListFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(`{"error": "%s"}`, err))
+		return
+	}
+	s.mu.Lock()
+	members := make([]*model.Member, 0, len(s.members))
+	for _, m := range s.members {
+		if status == "active" && m.Archived || status == "archived" && !m.Archived {
+			continue
+		}
+		if matchesTerm(term, m.Name, m.Email) && filter.matches(m.OfficeID, 0, 0, m.Archived, false, m.UpdatedAt) {
+			members = append(members, m)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+	writeJSON(w, http.StatusOK, paginate(s, w, r, members))
+}
+
+func (s *Server) getMember(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	m, ok := s.members[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, m)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, args []string) {
+	term := r.URL.Query().Get("term")
+	filter, err := parseListFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(`{"error": "%s"}`, err))
+		return
+	}
+	s.mu.Lock()
+	projects := make([]*model.Project, 0, len(s.projects))
+	for _, p := range s.projects {
+		if matchesTerm(term, p.Name, p.JobID) && filter.matches(p.OfficeID, p.ManagerID, p.ClientID, p.Archived, p.Closed, p.UpdatedAt) {
+			projects = append(projects, p)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	writeJSON(w, http.StatusOK, paginate(s, w, r, projects))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	p, ok := s.projects[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) listOffices(w http.ResponseWriter, r *http.Request, args []string) {
+	s.mu.Lock()
+	offices := make([]*model.Office, 0, len(s.offices))
+	for _, o := range s.offices {
+		offices = append(offices, o)
+	}
+	s.mu.Unlock()
+	sort.Slice(offices, func(i, j int) bool { return offices[i].ID < offices[j].ID })
+	writeJSON(w, http.StatusOK, offices)
+}
+
+func (s *Server) getOffice(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	o, ok := s.offices[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request, args []string) {
+	s.mu.Lock()
+	roles := make([]*model.Role, 0, len(s.roles))
+	for _, role := range s.roles {
+		roles = append(roles, role)
+	}
+	s.mu.Unlock()
+	sort.Slice(roles, func(i, j int) bool { return roles[i].ID < roles[j].ID })
+	writeJSON(w, http.StatusOK, roles)
+}
+
+func (s *Server) getRole(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	role, ok := s.roles[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) listActivities(w http.ResponseWriter, r *http.Request, args []string) {
+	s.mu.Lock()
+	activities := make([]*model.Activity, 0, len(s.activities))
+	for _, a := range s.activities {
+		activities = append(activities, a)
+	}
+	s.mu.Unlock()
+	sort.Slice(activities, func(i, j int) bool { return activities[i].ID < activities[j].ID })
+	writeJSON(w, http.StatusOK, activities)
+}
+
+func (s *Server) getActivity(w http.ResponseWriter, r *http.Request, args []string) {
+	id, _ := strconv.Atoi(args[0])
+	s.mu.Lock()
+	a, ok := s.activities[id]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) listAllocations(w http.ResponseWriter, r *http.Request, args []string) {
+	filter, err := parseReportFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(`{"error": "%s"}`, err))
+		return
+	}
+	userID := 0
+	if id := r.URL.Query().Get("user_id"); id != "" {
+		if userID, err = strconv.Atoi(id); err != nil {
+			writeError(w, http.StatusBadRequest, `{"error": "invalid user_id"}`)
+			return
+		}
+	}
+	s.mu.Lock()
+	allocations := make([]*model.Allocation, 0)
+	for _, a := range s.allocations {
+		if a.EndDate.Before(filter.start) || a.StartDate.After(filter.end) {
+			continue
+		}
+		if userID > 0 && a.UserID != userID {
+			continue
+		}
+		if filter.projectIDs != nil && !filter.projectIDs[a.ProjectID] {
+			continue
+		}
+		allocations = append(allocations, a)
+	}
+	s.mu.Unlock()
+	sort.Slice(allocations, func(i, j int) bool { return allocations[i].ID < allocations[j].ID })
+	writeJSON(w, http.StatusOK, allocations)
+}
+
+// allReports returns the seeded time reports and the logged time entries.
+// Must be called while holding the server lock.
+func (s *Server) allReports() []*model.MemberTimeReport {
+	reports := make([]*model.MemberTimeReport, 0, len(s.reports)+len(s.entries))
+	reports = append(reports, s.reports...)
+	return append(reports, s.timeEntryReports()...)
+}
+
+// reportFilter matches time reports using the time report query parameters
+type reportFilter struct {
+	start      dateutil.Date
+	end        dateutil.Date
+	projectIDs map[int]bool
+	clientID   int
+	officeID   int
+}
+
+func parseReportFilter(r *http.Request) (*reportFilter, error) {
+	query := r.URL.Query()
+	f := &reportFilter{}
+	var err error
+	if date := query.Get("date"); date != "" {
+		if f.start, err = dateutil.ParseDate(date); err != nil {
+			return nil, fmt.Errorf("invalid date")
+		}
+		f.end = f.start
+	} else {
+		if f.start, err = dateutil.ParseDate(query.Get("start")); err != nil {
+			return nil, fmt.Errorf("invalid start date")
+		}
+		if f.end, err = dateutil.ParseDate(query.Get("end")); err != nil {
+			return nil, fmt.Errorf("invalid end date")
+		}
+	}
+	if ids := query.Get("project_id"); ids != "" {
+		f.projectIDs = make(map[int]bool)
+		for _, id := range strings.Split(ids, ",") {
+			projectID, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid project_id")
+			}
+			f.projectIDs[projectID] = true
+		}
+	}
+	if id := query.Get("client_id"); id != "" {
+		if f.clientID, err = strconv.Atoi(id); err != nil {
+			return nil, fmt.Errorf("invalid client_id")
+		}
+	}
+	if id := query.Get("office_id"); id != "" {
+		if f.officeID, err = strconv.Atoi(id); err != nil {
+			return nil, fmt.Errorf("invalid office_id")
+		}
+	}
+	return f, nil
+}
+
+// matches must be called while holding the server lock
+func (f *reportFilter) matches(s *Server, tr *model.MemberTimeReport) bool {
+	if tr.Date.Before(f.start) || tr.Date.After(f.end) {
+		return false
+	}
+	if f.projectIDs != nil && !f.projectIDs[tr.ProjectID] {
+		return false
+	}
+	if f.clientID > 0 && tr.ClientID != f.clientID {
+		return false
+	}
+	if f.officeID > 0 {
+		p, ok := s.projects[tr.ProjectID]
+		if !ok || p.OfficeID != f.officeID {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) memberTimeReports(w http.ResponseWriter, r *http.Request, args []string) {
+	userID, _ := strconv.Atoi(args[0])
+	filter, err := parseReportFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(`{"error": "%s"}`, err))
+		return
+	}
+	s.mu.Lock()
+	if _, ok := s.members[userID]; !ok {
+		s.mu.Unlock()
+		writeNotFound(w)
+		return
+	}
+	reports := make([]*model.MemberTimeReport, 0)
+	for _, tr := range s.allReports() {
+		if tr.UserID == userID && filter.matches(s, tr) {
+			reports = append(reports, tr)
+		}
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, reports)
+}
@@ -0,0 +1,308 @@
+package gftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func newTestService(t *testing.T, srv *Server) *api.Service {
+	s, err := api.NewService(context.Background(), srv.Settings())
+	assert.NilError(t, err)
+	return s
+}
+
+func TestServer(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(start, end))
+	defer srv.Close()
+	s := newTestService(t, srv)
+
+	clients, err := s.Client.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(clients), 3)
+
+	client, err := s.Client.Get(2)
+	assert.NilError(t, err)
+	assert.Equal(t, client.Name, "Globex Corporation")
+
+	members, err := s.Member.Active()
+	assert.NilError(t, err)
+	assert.Equal(t, len(members), 2)
+
+	member, err := s.Member.FindByEmail("second@example.com")
+	assert.NilError(t, err)
+	assert.Equal(t, member.ID, 101)
+
+	office, err := s.Office.Get(10)
+	assert.NilError(t, err)
+	assert.Equal(t, office.Name, "London")
+
+	project, err := s.Project.Get(202)
+	assert.NilError(t, err)
+	assert.Equal(t, project.Name, "Pitch")
+
+	reports, err := s.Member.Reports.GetTimeReportsBetweenDates(100, start, end)
+	assert.NilError(t, err)
+	// 43 working days with two reports each
+	assert.Equal(t, len(reports), 86)
+
+	reports, err = s.Member.Reports.GetTimeReportsBetweenDates(101, start, start, api.FetchRelated())
+	assert.NilError(t, err)
+	assert.Equal(t, len(reports), 2)
+	assert.Equal(t, reports[0].Role.Name, "Developer")
+	assert.Equal(t, reports[0].Office.Name, "London")
+	assert.Equal(t, reports[0].Activity.Name, "Project Work")
+	assert.Equal(t, reports[1].Activity.Name, "Meetings")
+	srv.AssertRequested(t, "members/100/reports/time.json", 2)
+
+	projectReports, err := s.Project.Reports(start, end, api.WithProject(203))
+	assert.NilError(t, err)
+	// Both members report admin time on each working day
+	assert.Equal(t, len(projectReports), 86)
+	srv.AssertRequested(t, "members/100/reports/time.json", 4)
+
+	_, err = s.Client.Get(999)
+	assert.Assert(t, api.IsNotFound(err))
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	settings := srv.Settings()
+	settings.UserToken = "invalid"
+	s, err := api.NewService(context.Background(), settings)
+	assert.NilError(t, err)
+
+	_, err = s.Client.All()
+	assert.Assert(t, api.IsUnauthorized(err))
+}
+
+func TestServer_Faults(t *testing.T) {
+	srv := NewServerWithDataset(SampleDataset(time.Now(), time.Now()))
+	defer srv.Close()
+	s := newTestService(t, srv)
+
+	srv.FailNext("clients", http.StatusInternalServerError, 1)
+	_, err := s.Client.All()
+	assert.Assert(t, api.IsServerError(err))
+
+	clients, err := s.Client.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(clients), 3)
+	srv.AssertRequested(t, "clients.json", 2)
+	srv.AssertNotRequested(t, "projects")
+
+	srv.AddFault(&Fault{
+		Path:   "projects",
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"1"}},
+	})
+	_, err = s.Project.All()
+	assert.Assert(t, api.IsRateLimited(err))
+	var apiErr *api.Error
+	assert.Assert(t, errors.As(err, &apiErr))
+	assert.Equal(t, apiErr.RetryAfter, time.Second)
+
+	srv.ClearFaults()
+	srv.SetLatency(50 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Project.AllContext(ctx)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+}
+
+func TestServer_TimeReports_Cancel(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(start, end))
+	defer srv.Close()
+	s := newTestService(t, srv)
+	srv.SetLatency(50 * time.Millisecond)
+
+	for _, concurrency := range []int{1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			srv.ResetRequests()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			time.AfterFunc(75*time.Millisecond, cancel)
+
+			calls := s.Member.Reports.TimeReportsBetweenDates(100, start, end, api.WithConcurrency(concurrency))
+			_, err := calls.Context(ctx).Do()
+			assert.ErrorContains(t, err, context.Canceled.Error())
+
+			// Only the months requested before the cancellation reached the server
+			count := srv.RequestCount("members/100/reports/time.json")
+			assert.Assert(t, count > 0)
+			assert.Assert(t, count <= 2*concurrency, "got %d requests", count)
+
+			time.Sleep(150 * time.Millisecond)
+			srv.AssertRequested(t, "members/100/reports/time.json", count)
+		})
+	}
+}
+
+func TestServer_TimeEntries(t *testing.T) {
+	day := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(day, day))
+	defer srv.Close()
+	s := newTestService(t, srv)
+
+	entry := &model.TimeEntry{
+		Date:       dateutil.DateOf(day),
+		ProjectID:  201,
+		ActivityID: 1,
+		Hours:      1.5,
+	}
+	res, err := s.TimeEntry.Create(100, entry).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, res.TimeEntry.ID, 1)
+	assert.Equal(t, res.TimeEntry.UserID, 100)
+
+	reports, err := s.Project.Reports(day, day, api.WithProject(201))
+	assert.NilError(t, err)
+	assert.Equal(t, len(reports), 2)
+
+	entry = res.TimeEntry
+	entry.Hours = 2
+	_, err = s.TimeEntry.Update(100, entry).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, srv.TimeEntries()[0].Hours, 2.0)
+
+	srv.ResetRequests()
+	closed := &model.TimeEntry{Date: dateutil.DateOf(day), ProjectID: 204, Hours: 1}
+	_, err = s.TimeEntry.Create(100, closed).Do()
+	assert.Assert(t, errors.Is(err, api.ErrProjectClosed))
+	srv.AssertNotRequested(t, "members/100/time_entries.json")
+
+	err = s.TimeEntry.Delete(100, entry.ID).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, len(srv.TimeEntries()), 0)
+
+	err = s.TimeEntry.Delete(100, entry.ID).Do()
+	assert.Assert(t, api.IsNotFound(err))
+}
+
+func TestServer_TimeEntries_Cache(t *testing.T) {
+	day := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(day, day))
+	defer srv.Close()
+	cache, err := api.NewFileCache(t.TempDir())
+	assert.NilError(t, err)
+	settings := srv.Settings()
+	settings.Cache = cache
+	s, err := api.NewService(context.Background(), settings)
+	assert.NilError(t, err)
+
+	// Past month reports are cached without expiry
+	reports, err := s.Member.Reports.GetTimeReportsBetweenDates(100, day, day, api.WithProject(201))
+	assert.NilError(t, err)
+	assert.Equal(t, len(reports), 0)
+	_, err = s.Member.Reports.GetTimeReportsBetweenDates(100, day, day, api.WithProject(201))
+	assert.NilError(t, err)
+	srv.AssertRequested(t, "members/100/reports/time.json", 1)
+
+	entry := &model.TimeEntry{Date: dateutil.DateOf(day), ProjectID: 201, Hours: 1.5}
+	_, err = s.TimeEntry.Create(100, entry).Do()
+	assert.NilError(t, err)
+
+	// Logging time removes the cached reports of the member
+	reports, err = s.Member.Reports.GetTimeReportsBetweenDates(100, day, day, api.WithProject(201))
+	assert.NilError(t, err)
+	assert.Equal(t, len(reports), 1)
+	assert.Equal(t, reports[0].Actual, 1.5)
+	srv.AssertRequested(t, "members/100/reports/time.json", 2)
+}
+
+func TestServer_Allocations(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(start, end))
+	defer srv.Close()
+	s := newTestService(t, srv)
+	ctx := context.Background()
+
+	allocations, err := s.Allocation.GetMemberAllocations(ctx, 100, start, end)
+	assert.NilError(t, err)
+	assert.Equal(t, len(allocations), 2)
+	// 23 working days in January 2020
+	assert.Equal(t, allocations[0].TotalHours(), 138.0)
+
+	allocations, err = s.Allocation.GetProjectAllocations(ctx, 201, start, end)
+	assert.NilError(t, err)
+	assert.Equal(t, len(allocations), 1)
+	assert.Equal(t, allocations[0].UserID, 101)
+
+	next := time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)
+	allocations, err = s.Allocation.GetMemberAllocations(ctx, 100, next, next.AddDate(0, 1, -1))
+	assert.NilError(t, err)
+	assert.Equal(t, len(allocations), 0)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	srv := NewServerWithDataset(SampleDataset(start, start))
+	defer srv.Close()
+	srv.SetPageSize(2)
+	s := newTestService(t, srv)
+
+	projects, err := s.Project.All(api.WithCache(false))
+	assert.NilError(t, err)
+	assert.Equal(t, len(projects), 5)
+	assert.Equal(t, srv.RequestCount("projects.json"), 3)
+
+	res, err := s.Client.List(api.WithPageSize(1)).Page(3).Do()
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Clients), 1)
+	assert.Equal(t, res.Clients[0].ID, 3)
+	assert.Equal(t, res.NextPageURL, "")
+
+	members, err := s.Member.All()
+	assert.NilError(t, err)
+	assert.Equal(t, len(members), 3)
+}
+
+func TestServer_ListFilters(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ds := SampleDataset(start, start)
+	ds.Projects[1].UpdatedAt = dateutil.DateTimeOf(start.Add(2 * time.Hour))
+	srv := NewServerWithDataset(ds)
+	defer srv.Close()
+	s := newTestService(t, srv)
+
+	projects, err := s.Project.All(api.WithManagerFilter(101))
+	assert.NilError(t, err)
+	assert.Equal(t, len(projects), 1)
+	assert.Equal(t, projects[0].ID, 201)
+
+	projects, err = s.Project.All(api.WithClientFilter(1), api.WithClosed(false))
+	assert.NilError(t, err)
+	assert.Equal(t, len(projects), 1)
+	assert.Equal(t, projects[0].ID, 200)
+
+	projects, err = s.Project.All(api.WithUpdatedSince(start.Add(time.Hour)))
+	assert.NilError(t, err)
+	assert.Equal(t, len(projects), 1)
+	assert.Equal(t, projects[0].ID, 201)
+
+	members, err := s.Member.All(api.WithArchived(true), api.WithOfficeFilter(10))
+	assert.NilError(t, err)
+	assert.Equal(t, len(members), 1)
+	assert.Equal(t, members[0].ID, 102)
+
+	clients, err := s.Client.All(api.WithOfficeFilter(99))
+	assert.NilError(t, err)
+	assert.Equal(t, len(clients), 0)
+
+	req := srv.Requests()[0]
+	assert.Equal(t, req.Query.Get("manager_id"), "101")
+}
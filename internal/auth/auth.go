@@ -111,21 +111,24 @@ func (b *Auth) Validate() error {
 	return nil
 }
 
-// NewService creates new Glass Factory service
-func (b *Auth) NewService() (*api.Service, error) {
+// Settings returns API settings for the authentication details
+func (b *Auth) Settings() (*api.Settings, error) {
 	if b.Account == "" || b.Email == "" || b.APIKey == "" {
 		err := b.Setup()
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to create new Glass Factory service")
 		}
 	}
-	ctx := context.Background()
-	var err error
-	var settings *api.Settings
-	settings, err = api.NewSettings(b.Account, b.Email, b.APIKey)
+	return api.NewSettings(b.Account, b.Email, b.APIKey)
+}
+
+// NewService creates new Glass Factory service
+func (b *Auth) NewService() (*api.Service, error) {
+	settings, err := b.Settings()
 	if err != nil {
 		return nil, err
 	}
+	ctx := context.Background()
 	var service *api.Service
 	service, err = api.NewService(ctx, settings)
 	if err != nil {
@@ -0,0 +1,38 @@
+// Package cmdutil provides helpers shared by the CLI commands
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/internal/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NewAPIService creates an API service using the authentication details in the command
+// context. The configure function can be used for changing the settings before the
+// service is created.
+func NewAPIService(cmd *cobra.Command, configure func(settings *api.Settings) error) (*api.Service, error) {
+	gfAuth, ok := auth.FromContext(cmd.Context())
+	if !ok {
+		return nil, fmt.Errorf("failed to get authentication details")
+	}
+	settings, err := gfAuth.Settings()
+	if err != nil {
+		return nil, err
+	}
+	if viper.GetBool("debug") {
+		settings.DebugLog = os.Stderr
+		settings.DebugJSON = true
+	} else if viper.GetBool("verbose") {
+		settings.DebugLog = os.Stderr
+	}
+	if configure != nil {
+		if err := configure(settings); err != nil {
+			return nil, err
+		}
+	}
+	return api.NewService(cmd.Context(), settings)
+}
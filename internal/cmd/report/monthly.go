@@ -2,10 +2,9 @@ package report
 
 import (
 	"fmt"
-	"os"
 	"time"
 
-	"github.com/markosamuli/glassfactory/internal/auth"
+	"github.com/markosamuli/glassfactory/reporting"
 	"github.com/spf13/cobra"
 )
 
@@ -32,22 +31,17 @@ func NewMonthlyReportCommand() *cobra.Command {
 
 // Run the command
 func (o *MonthlyReportOptions) Run(cmd *cobra.Command) error {
-	gfAuth, ok := auth.FromContext(cmd.Context())
-	if !ok {
-		return fmt.Errorf("failed to get authentication details")
-	}
-
-	s, err := gfAuth.NewService()
+	s, err := createAPIService(cmd)
 	if err != nil {
 		return err
 	}
 
-	member, err := s.GetCurrentMember()
+	member, err := s.GetCurrentMemberContext(cmd.Context())
 	if err != nil {
 		return err
 	}
 
-	r, err := createReportingService(s)
+	r, err := createReportingService(cmd.Context(), s)
 	if err != nil {
 		return err
 	}
@@ -56,8 +50,9 @@ func (o *MonthlyReportOptions) Run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	reports := make([]reporting.PeriodReport, 0, len(monthlyReports))
 	for _, r := range monthlyReports {
-		r.RenderTable(os.Stdout)
+		reports = append(reports, r)
 	}
-	return nil
+	return writeReports("Month", reports)
 }
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/markosamuli/glassfactory/reporting"
+)
+
+func formatUsage() string {
+	formats := make([]string, 0)
+	for _, f := range reporting.Formats() {
+		formats = append(formats, string(f))
+	}
+	return fmt.Sprintf("Output format (%s)", strings.Join(formats, ", "))
+}
+
+// writeReports renders the reports to the output file or stdout
+func writeReports(period string, reports []reporting.PeriodReport) error {
+	if output == "" {
+		return renderReports(os.Stdout, period, reports)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := renderReports(f, period, reports); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// renderReports writes the reports with the template or in the selected output
+// format. Tables are rendered for each report separately and other formats as
+// a single document.
+func renderReports(w io.Writer, period string, reports []reporting.PeriodReport) error {
+	if templateName != "" {
+		t, err := reporting.LoadTemplate(templateName)
+		if err != nil {
+			return err
+		}
+		return t.Execute(w, &reporting.TemplateData{Period: period, Reports: reports})
+	}
+	f := reporting.Format(format)
+	if f == reporting.FormatTable {
+		for _, r := range reports {
+			table := reporting.NewTableRenderer(w, period)
+			r.AppendTo(table)
+			if err := table.Render(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	renderer, err := reporting.NewRenderer(f, w, period, renderOptions()...)
+	if err != nil {
+		return err
+	}
+	for _, r := range reports {
+		r.AppendTo(renderer)
+	}
+	return renderer.Render()
+}
+
+func templateUsage() string {
+	return fmt.Sprintf("Render the reports with a text/template file or a built-in template (%s)",
+		strings.Join(reporting.BuiltinTemplates(), ", "))
+}
+
+// renderOptions returns the render options set with the flags
+func renderOptions() []reporting.RenderOption {
+	opts := make([]reporting.RenderOption, 0)
+	if highlight {
+		opts = append(opts, reporting.WithHighlight(highlightThreshold))
+	}
+	return opts
+}
@@ -2,14 +2,54 @@ package report
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/internal/cmd/cmdutil"
 	"github.com/markosamuli/glassfactory/reporting"
 	"github.com/spf13/cobra"
 )
 
-func createReportingService(api *api.Service) (*reporting.Service, error) {
-	ctx := context.Background()
+// cacheRetention is how long expired responses are kept for offline use
+const cacheRetention = 30 * 24 * time.Hour
+
+var ( // Used for flags.
+	offline bool
+	noCache bool
+	format  string
+	output  string
+
+	templateName string
+
+	highlight          bool
+	highlightThreshold float64
+)
+
+func createAPIService(cmd *cobra.Command) (*api.Service, error) {
+	return cmdutil.NewAPIService(cmd, func(settings *api.Settings) error {
+		if noCache && !offline {
+			return nil
+		}
+		dir, err := api.DefaultCacheDir()
+		if err != nil {
+			return err
+		}
+		cache, err := api.NewFileCache(dir)
+		if err != nil {
+			return err
+		}
+		if !offline {
+			// Failing to clean up the cache shouldn't fail the command
+			_ = cache.Prune(time.Now().Add(-cacheRetention))
+		}
+		settings.Cache = cache
+		settings.Offline = offline
+		return nil
+	})
+}
+
+func createReportingService(ctx context.Context, api *api.Service) (*reporting.Service, error) {
 	r, err := reporting.NewService(ctx, api)
 	if err != nil {
 		return nil, err
@@ -23,7 +63,27 @@ func NewCommand() *cobra.Command {
 		Use:   "report",
 		Short: "Print time reports",
 		Long:  `Print time reports for a user`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			f, err := reporting.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if templateName != "" && cmd.Flags().Changed("format") {
+				return fmt.Errorf("--template can't be used with --format")
+			}
+			if f == reporting.FormatXLSX && output == "" {
+				return fmt.Errorf("%s format requires --output", f)
+			}
+			return nil
+		},
 	}
+	c.PersistentFlags().BoolVar(&offline, "offline", false, "Use only cached data without calling Glass Factory")
+	c.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Don't cache Glass Factory responses")
+	c.PersistentFlags().StringVar(&format, "format", string(reporting.FormatTable), formatUsage())
+	c.PersistentFlags().StringVar(&templateName, "template", "", templateUsage())
+	c.PersistentFlags().StringVarP(&output, "output", "o", "", "Write the reports to a file instead of stdout")
+	c.PersistentFlags().BoolVar(&highlight, "highlight", false, "Highlight over- and under-planned rows in HTML output")
+	c.PersistentFlags().Float64Var(&highlightThreshold, "highlight-threshold", 0, "Hours actual and planned may differ before rows are highlighted")
 	c.AddCommand(NewMonthlyReportCommand())
 	c.AddCommand(NewFiscalYearReportCommand())
 	return c
@@ -8,6 +8,7 @@ import (
 	"github.com/markosamuli/glassfactory/internal/auth"
 	authCmd "github.com/markosamuli/glassfactory/internal/cmd/auth"
 	"github.com/markosamuli/glassfactory/internal/cmd/report"
+	"github.com/markosamuli/glassfactory/internal/cmd/timeentry"
 	"github.com/markosamuli/glassfactory/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,6 +17,7 @@ import (
 var ( // Used for flags.
 	cfgFile string
 	verbose bool
+	debug   bool
 	gfAuth  = auth.NewAuth()
 	rootCmd = &cobra.Command{
 		Use:   "glassfactory",
@@ -40,13 +42,17 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ~/.glassfactory.yaml)")
 	rootCmd.PersistentFlags().StringVar(&gfAuth.Account, "account", "", "Glass Factory account subdomain")
 	rootCmd.PersistentFlags().StringVar(&gfAuth.Email, "email", "", "Glass Factory user email address")
-	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output including Glass Factory API requests")
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Log Glass Factory API requests as JSON lines")
 
 	viper.BindPFlag("account", rootCmd.PersistentFlags().Lookup("account"))
 	viper.BindPFlag("email", rootCmd.PersistentFlags().Lookup("email"))
+	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
+	viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
 
 	rootCmd.AddCommand(authCmd.NewCommand())
 	rootCmd.AddCommand(report.NewCommand())
+	rootCmd.AddCommand(timeentry.NewCommand())
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -0,0 +1,107 @@
+package timeentry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/internal/cmd/cmdutil"
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"github.com/spf13/cobra"
+)
+
+// LogOptions for the time log command
+type LogOptions struct {
+	Date       string
+	ProjectID  int
+	ActivityID int
+	Hours      float64
+	Comment    string
+}
+
+// NewLogCommand creates new command
+func NewLogCommand() *cobra.Command {
+	var o = &LogOptions{}
+	var c = &cobra.Command{
+		Use:   "log",
+		Short: "Log time on a project",
+		Long:  `Log time on a project for the current user`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := o.Run(cmd)
+			if err != nil {
+				fmt.Print(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&o.Date, "date", "", "Date in YYYY-MM-DD format (default is today)")
+	c.Flags().IntVar(&o.ProjectID, "project", 0, "Project ID")
+	c.Flags().IntVar(&o.ActivityID, "activity", 0, "Activity ID")
+	c.Flags().Float64Var(&o.Hours, "hours", 0, "Number of hours")
+	c.Flags().StringVar(&o.Comment, "comment", "", "Comment for the time entry")
+	c.MarkFlagRequired("project")
+	c.MarkFlagRequired("hours")
+	return c
+}
+
+// TimeEntry returns the time entry to be logged
+func (o *LogOptions) TimeEntry() (*model.TimeEntry, error) {
+	date := dateutil.DateOf(time.Now())
+	if o.Date != "" {
+		d, err := dateutil.ParseDate(o.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %s", o.Date)
+		}
+		date = d
+	}
+	return &model.TimeEntry{
+		Date:       date,
+		ProjectID:  o.ProjectID,
+		ActivityID: o.ActivityID,
+		Hours:      o.Hours,
+		Comment:    o.Comment,
+	}, nil
+}
+
+// Run the command
+func (o *LogOptions) Run(cmd *cobra.Command) error {
+	entry, err := o.TimeEntry()
+	if err != nil {
+		return err
+	}
+
+	// Use the report cache so that the cached time reports of the member are
+	// removed after logging time
+	s, err := cmdutil.NewAPIService(cmd, func(settings *api.Settings) error {
+		dir, err := api.DefaultCacheDir()
+		if err != nil {
+			return err
+		}
+		cache, err := api.NewFileCache(dir)
+		if err != nil {
+			return err
+		}
+		settings.Cache = cache
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	member, err := s.GetCurrentMemberContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	res, err := s.TimeEntry.Create(member.ID, entry).Context(cmd.Context()).Do()
+	if err != nil {
+		return err
+	}
+
+	project, err := s.Project.GetContext(cmd.Context(), res.TimeEntry.ProjectID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Logged %.2f hours on %s on %s\n", res.TimeEntry.Hours, project.Name, res.TimeEntry.Date)
+	return nil
+}
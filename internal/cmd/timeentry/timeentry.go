@@ -0,0 +1,14 @@
+package timeentry
+
+import "github.com/spf13/cobra"
+
+// NewCommand creates new time command
+func NewCommand() *cobra.Command {
+	var c = &cobra.Command{
+		Use:   "time",
+		Short: "Manage time entries",
+		Long:  `Log and manage time entries in Glass Factory`,
+	}
+	c.AddCommand(NewLogCommand())
+	return c
+}
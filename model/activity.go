@@ -0,0 +1,7 @@
+package model
+
+// Activity represents a type of work logged in Glass Factory time reports
+type Activity struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestActivity(t *testing.T) {
+	jsonString := `
+	{
+		"id": 42,
+		"name": "Meetings"
+	}`
+	var activity Activity
+	err := json.Unmarshal([]byte(jsonString), &activity)
+	assert.NilError(t, err)
+
+	assert.Equal(t, activity.ID, 42)
+	assert.Equal(t, activity.Name, "Meetings")
+}
+
+func TestActivityCollection(t *testing.T) {
+	c := NewActivityCollection()
+	c.Add(&Activity{ID: 1, Name: "Design"})
+	c.Add(&Activity{ID: 2, Name: "Meetings"})
+	assert.Equal(t, c.Count(), 2)
+
+	meetings := c.Filter(func(a *Activity) bool { return a.Name == "Meetings" })
+	assert.Equal(t, meetings.Count(), 1)
+	activity, ok := meetings.Get(2)
+	assert.Assert(t, ok)
+	assert.Equal(t, activity.Name, "Meetings")
+}
@@ -0,0 +1,16 @@
+package model
+
+// ActivityCollection represents unique set of activities
+type ActivityCollection struct {
+	*Collection[int, *Activity]
+}
+
+// NewActivityCollection is used for creating ActivityCollection
+func NewActivityCollection() *ActivityCollection {
+	return &ActivityCollection{NewCollection(func(a *Activity) int { return a.ID })}
+}
+
+// Filter returns a new collection that contains activities matching the predicate
+func (c *ActivityCollection) Filter(f func(*Activity) bool) *ActivityCollection {
+	return &ActivityCollection{c.Collection.Filter(f)}
+}
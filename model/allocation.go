@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// Allocation represents a resource booking of a team member on a project
+type Allocation struct {
+	ID        int           `json:"id"`
+	UserID    int           `json:"user_id"`
+	ProjectID int           `json:"project_id"`
+	RoleID    int           `json:"role_id,omitempty"`
+	StartDate dateutil.Date `json:"start_date"`
+	EndDate   dateutil.Date `json:"end_date"`
+	Hours     float64       `json:"hours"` // Planned hours per working day
+	Tentative bool          `json:"tentative,omitempty"`
+}
+
+// WorkingDays returns the number of weekdays between the start and end dates
+func (a *Allocation) WorkingDays() int {
+	return a.WorkingDaysBetween(a.StartDate, a.EndDate)
+}
+
+// WorkingDaysBetween returns the number of allocated weekdays between the given dates
+func (a *Allocation) WorkingDaysBetween(start dateutil.Date, end dateutil.Date) int {
+	if start.Before(a.StartDate) {
+		start = a.StartDate
+	}
+	if end.After(a.EndDate) {
+		end = a.EndDate
+	}
+	days := 0
+	for d := start.In(time.UTC); !dateutil.DateOf(d).After(end); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	return days
+}
+
+// TotalHours returns the planned hours over the whole allocation
+func (a *Allocation) TotalHours() float64 {
+	return a.Hours * float64(a.WorkingDays())
+}
+
+// HoursBetween returns the planned hours between the given dates
+func (a *Allocation) HoursBetween(start dateutil.Date, end dateutil.Date) float64 {
+	return a.Hours * float64(a.WorkingDaysBetween(start, end))
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func TestAllocation(t *testing.T) {
+	jsonString := `
+	{
+		"id": 55,
+		"user_id": 123,
+		"project_id": 222,
+		"role_id": 1480,
+		"start_date": "2019-09-02",
+		"end_date": "2019-09-15",
+		"hours": 6,
+		"tentative": true
+	}`
+	var allocation Allocation
+	err := json.Unmarshal([]byte(jsonString), &allocation)
+	assert.NilError(t, err)
+
+	assert.Equal(t, allocation.ID, 55)
+	assert.Equal(t, allocation.UserID, 123)
+	assert.Equal(t, allocation.ProjectID, 222)
+	assert.Equal(t, allocation.RoleID, 1480)
+	assert.Equal(t, allocation.StartDate.String(), "2019-09-02")
+	assert.Equal(t, allocation.EndDate.String(), "2019-09-15")
+	assert.Equal(t, allocation.Hours, 6.0)
+	assert.Assert(t, allocation.Tentative)
+
+	// Two full weeks from Monday to Sunday
+	assert.Equal(t, allocation.WorkingDays(), 10)
+	assert.Equal(t, allocation.TotalHours(), 60.0)
+
+	// Range starting before the allocation and ending mid-week
+	start := dateutil.DateOf(time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC))
+	end := dateutil.DateOf(time.Date(2019, time.September, 4, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, allocation.WorkingDaysBetween(start, end), 3)
+	assert.Equal(t, allocation.HoursBetween(start, end), 18.0)
+
+	// Range outside the allocation
+	end = dateutil.DateOf(time.Date(2019, time.August, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, allocation.HoursBetween(start, end), 0.0)
+}
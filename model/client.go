@@ -1,14 +1,19 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
 
 // Client represents client details in Glass Factory
 type Client struct {
-	ID         int       `json:"id"`
-	Name       string    `json:"name"`
-	ArchivedAt time.Time `json:"archived_at,omitempty"`
-	OwnerID    int       `json:"owner_id"`
-	OfficeID   int       `json:"office_id"`
+	ID         int               `json:"id"`
+	Name       string            `json:"name"`
+	ArchivedAt time.Time         `json:"archived_at,omitempty"`
+	OwnerID    int               `json:"owner_id"`
+	OfficeID   int               `json:"office_id"`
+	UpdatedAt  dateutil.DateTime `json:"updated_at,omitempty"`
 }
 
 // IsArchived returns true if archive date has been defined
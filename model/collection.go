@@ -0,0 +1,170 @@
+package model
+
+import (
+	"sort"
+	"sync"
+)
+
+// Collection represents unique set of items indexed by a key. It is safe for
+// concurrent use by multiple goroutines.
+type Collection[K comparable, V any] struct {
+	mu    sync.RWMutex
+	key   func(V) K
+	items map[K]V
+	order []K // Keys in the order the items were added
+	limit int // Maximum number of items, zero means unlimited
+}
+
+// NewCollection is used for creating Collection with a function returning
+// the key for each item
+func NewCollection[K comparable, V any](key func(V) K) *Collection[K, V] {
+	c := &Collection[K, V]{key: key}
+	c.items = make(map[K]V)
+	return c
+}
+
+// SetLimit sets the maximum number of items kept in the collection. When the
+// limit is exceeded, the items that were added first are evicted. Zero removes
+// the limit.
+func (c *Collection[K, V]) SetLimit(limit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limit = limit
+	c.evict()
+}
+
+// Limit returns the maximum number of items kept in the collection
+func (c *Collection[K, V]) Limit() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.limit
+}
+
+// Count returns number of items in the collection
+func (c *Collection[K, V]) Count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.items)
+}
+
+// Get returns a single item from the collection, if found
+func (c *Collection[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	item, ok := c.items[key]
+	return item, ok
+}
+
+// Add an item to the collection, replacing any existing item with the same key
+func (c *Collection[K, V]) Add(item V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := c.key(item)
+	if _, ok := c.items[key]; ok {
+		c.removeKey(key)
+	}
+	c.items[key] = item
+	c.order = append(c.order, key)
+	c.evict()
+}
+
+// Remove an item from the collection and return true if it was found
+func (c *Collection[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[key]; !ok {
+		return false
+	}
+	delete(c.items, key)
+	c.removeKey(key)
+	return true
+}
+
+// Take returns an item from the collection
+func (c *Collection[K, V]) Take() V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, item := range c.items {
+		return item
+	}
+	var zero V
+	return zero
+}
+
+// All returns all items from the collection
+func (c *Collection[K, V]) All() []V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	items := make([]V, 0, len(c.items))
+	for _, item := range c.items {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Keys returns the keys of all items in the order they were added
+func (c *Collection[K, V]) Keys() []K {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	keys := make([]K, len(c.order))
+	copy(keys, c.order)
+	return keys
+}
+
+// SortedBy returns all items from the collection sorted using the less function
+func (c *Collection[K, V]) SortedBy(less func(a, b V) bool) []V {
+	items := c.All()
+	sort.SliceStable(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+	return items
+}
+
+// Range calls f for each item in the order they were added. If f returns
+// false, the iteration stops.
+func (c *Collection[K, V]) Range(f func(V) bool) {
+	c.mu.RLock()
+	items := make([]V, 0, len(c.order))
+	for _, key := range c.order {
+		items = append(items, c.items[key])
+	}
+	c.mu.RUnlock()
+	for _, item := range items {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// Filter returns a new collection that contains items matching the predicate
+func (c *Collection[K, V]) Filter(f func(V) bool) *Collection[K, V] {
+	fc := NewCollection[K, V](c.key)
+	c.Range(func(item V) bool {
+		if f(item) {
+			fc.Add(item)
+		}
+		return true
+	})
+	return fc
+}
+
+// removeKey removes the key from the insertion order
+func (c *Collection[K, V]) removeKey(key K) {
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// evict removes the oldest items when the collection exceeds its limit
+func (c *Collection[K, V]) evict() {
+	if c.limit <= 0 {
+		return
+	}
+	for len(c.order) > c.limit {
+		delete(c.items, c.order[0])
+		c.order = c.order[1:]
+	}
+}
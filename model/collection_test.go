@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestCollection(t *testing.T) {
+	c := NewCollection(func(c *Client) int { return c.ID })
+	assert.Equal(t, c.Count(), 0)
+
+	var nc *Client
+	assert.Equal(t, c.Take(), nc, "Take() should return nil when there are no items in the collection")
+
+	c3 := &Client{ID: 3, Name: "C"}
+	c1 := &Client{ID: 1, Name: "A"}
+	c2 := &Client{ID: 2, Name: "B"}
+	c.Add(c3)
+	c.Add(c1)
+	c.Add(c2)
+	assert.Equal(t, c.Count(), 3)
+	assert.DeepEqual(t, c.Keys(), []int{3, 1, 2})
+
+	sorted := c.SortedBy(func(a, b *Client) bool { return a.ID < b.ID })
+	assert.DeepEqual(t, sorted, []*Client{c1, c2, c3})
+
+	var visited []int
+	c.Range(func(client *Client) bool {
+		visited = append(visited, client.ID)
+		return client.ID != 1
+	})
+	assert.DeepEqual(t, visited, []int{3, 1})
+
+	assert.Assert(t, c.Remove(3))
+	assert.Assert(t, !c.Remove(3), "Remove() should return false for missing items")
+	_, ok := c.Get(3)
+	assert.Assert(t, !ok)
+	assert.DeepEqual(t, c.Keys(), []int{1, 2})
+
+	// Adding an existing item replaces it and moves it last
+	c1b := &Client{ID: 1, Name: "A2"}
+	c.Add(c1b)
+	assert.DeepEqual(t, c.Keys(), []int{2, 1})
+	ct, _ := c.Get(1)
+	assert.Equal(t, ct, c1b)
+
+	filtered := c.Filter(func(client *Client) bool { return client.Name == "B" })
+	assert.Equal(t, filtered.Count(), 1)
+	assert.Equal(t, filtered.Take(), c2)
+}
+
+func TestCollection_SetLimit(t *testing.T) {
+	c := NewCollection(func(m *Member) int { return m.ID })
+	c.SetLimit(3)
+	assert.Equal(t, c.Limit(), 3)
+	for i := 1; i <= 5; i++ {
+		c.Add(&Member{ID: i})
+	}
+	assert.Equal(t, c.Count(), 3)
+	assert.DeepEqual(t, c.Keys(), []int{3, 4, 5})
+
+	c.SetLimit(1)
+	assert.DeepEqual(t, c.Keys(), []int{5})
+
+	c.SetLimit(0)
+	c.Add(&Member{ID: 6})
+	c.Add(&Member{ID: 7})
+	assert.Equal(t, c.Count(), 3)
+}
+
+func TestCollection_Concurrent(t *testing.T) {
+	c := NewProjectCollection()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				id := i*100 + j
+				c.Add(&Project{ID: id, Name: fmt.Sprintf("Project %d", id)})
+				c.Get(id)
+				c.WithOffice(0).Count()
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, c.Count(), 1000)
+}
@@ -17,4 +17,7 @@ type MemberTimeReport struct {
 	RoleID     int           `json:"role_id,omitempty"`
 	Client     *Client
 	Project    *Project
+	Office     *Office
+	Role       *Role
+	Activity   *Activity
 }
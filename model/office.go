@@ -0,0 +1,7 @@
+package model
+
+// Office represents office details in Glass Factory
+type Office struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
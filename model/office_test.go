@@ -0,0 +1,36 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestOffice(t *testing.T) {
+	jsonString := `
+	{
+		"id": 789,
+		"name": "London"
+	}`
+	var office Office
+	err := json.Unmarshal([]byte(jsonString), &office)
+	assert.NilError(t, err)
+
+	assert.Equal(t, office.ID, 789)
+	assert.Equal(t, office.Name, "London")
+}
+
+func TestOfficeCollection(t *testing.T) {
+	c := NewOfficeCollection()
+	c.Add(&Office{ID: 1, Name: "London"})
+	c.Add(&Office{ID: 2, Name: "New York"})
+	assert.Equal(t, c.Count(), 2)
+
+	office, ok := c.Get(2)
+	assert.Assert(t, ok)
+	assert.Equal(t, office.Name, "New York")
+
+	london := c.Filter(func(o *Office) bool { return o.Name == "London" })
+	assert.Equal(t, london.Count(), 1)
+}
@@ -0,0 +1,16 @@
+package model
+
+// OfficeCollection represents unique set of offices
+type OfficeCollection struct {
+	*Collection[int, *Office]
+}
+
+// NewOfficeCollection is used for creating OfficeCollection
+func NewOfficeCollection() *OfficeCollection {
+	return &OfficeCollection{NewCollection(func(o *Office) int { return o.ID })}
+}
+
+// Filter returns a new collection that contains offices matching the predicate
+func (c *OfficeCollection) Filter(f func(*Office) bool) *OfficeCollection {
+	return &OfficeCollection{c.Collection.Filter(f)}
+}
@@ -22,4 +22,5 @@ type Project struct {
 	ProbabilityState  string            `json:"probability_state"`
 	EnableGeneralTime bool              `json:"enable_general_time,omitempty"`
 	Pricing           *ProjectPricing   `json:"pricing"`
+	UpdatedAt         dateutil.DateTime `json:"updated_at,omitempty"`
 }
@@ -0,0 +1,9 @@
+package model
+
+// Role represents a team member role in Glass Factory
+type Role struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Rate     float64 `json:"rate"`     // Default hourly rate for the role
+	Currency string  `json:"currency"` // Currency of the default rate
+}
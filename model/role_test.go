@@ -0,0 +1,38 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestRole(t *testing.T) {
+	jsonString := `
+	{
+		"id": 1000,
+		"name": "Designer",
+		"rate": 95.5,
+		"currency": "GBP"
+	}`
+	var role Role
+	err := json.Unmarshal([]byte(jsonString), &role)
+	assert.NilError(t, err)
+
+	assert.Equal(t, role.ID, 1000)
+	assert.Equal(t, role.Name, "Designer")
+	assert.Equal(t, role.Rate, 95.5)
+	assert.Equal(t, role.Currency, "GBP")
+}
+
+func TestRoleCollection(t *testing.T) {
+	c := NewRoleCollection()
+	c.Add(&Role{ID: 1, Name: "Designer"})
+	c.Add(&Role{ID: 2, Name: "Developer"})
+	c.Add(&Role{ID: 1, Name: "Senior Designer"})
+	assert.Equal(t, c.Count(), 2)
+
+	role, ok := c.Get(1)
+	assert.Assert(t, ok)
+	assert.Equal(t, role.Name, "Senior Designer")
+}
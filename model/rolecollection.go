@@ -0,0 +1,16 @@
+package model
+
+// RoleCollection represents unique set of roles
+type RoleCollection struct {
+	*Collection[int, *Role]
+}
+
+// NewRoleCollection is used for creating RoleCollection
+func NewRoleCollection() *RoleCollection {
+	return &RoleCollection{NewCollection(func(r *Role) int { return r.ID })}
+}
+
+// Filter returns a new collection that contains roles matching the predicate
+func (c *RoleCollection) Filter(f func(*Role) bool) *RoleCollection {
+	return &RoleCollection{c.Collection.Filter(f)}
+}
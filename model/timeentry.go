@@ -0,0 +1,16 @@
+package model
+
+import (
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// TimeEntry represents time logged by a team member on a project
+type TimeEntry struct {
+	ID         int           `json:"id,omitempty"`
+	UserID     int           `json:"user_id,omitempty"`
+	Date       dateutil.Date `json:"date"`
+	ProjectID  int           `json:"project_id"`
+	ActivityID int           `json:"activity_id,omitempty"`
+	Hours      float64       `json:"time"`
+	Comment    string        `json:"comment,omitempty"`
+}
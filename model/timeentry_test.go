@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func TestTimeEntry(t *testing.T) {
+	entry := &TimeEntry{
+		UserID:     123,
+		Date:       dateutil.DateOf(time.Date(2019, time.September, 2, 0, 0, 0, 0, time.UTC)),
+		ProjectID:  222,
+		ActivityID: 42,
+		Hours:      7.5,
+	}
+	data, err := json.Marshal(entry)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"user_id":123,"date":"2019-09-02","project_id":222,"activity_id":42,"time":7.5}`)
+
+	var decoded TimeEntry
+	err = json.Unmarshal([]byte(`{"id": 9, "user_id": 123, "date": "2019-09-02", "project_id": 222, "time": 7.5, "comment": "Design"}`), &decoded)
+	assert.NilError(t, err)
+	assert.Equal(t, decoded.ID, 9)
+	assert.Equal(t, decoded.Date, entry.Date)
+	assert.Equal(t, decoded.Hours, 7.5)
+	assert.Equal(t, decoded.Comment, "Design")
+}
@@ -0,0 +1,39 @@
+package reporting
+
+import (
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// ActivityMemberTimeReport represents time report data for a given activity and team member
+type ActivityMemberTimeReport struct {
+	memberTimeReportGroup
+	ActivityID int
+	Activity   *model.Activity
+}
+
+// NewActivityMemberTimeReport creates a new ActivityMemberTimeReport for the given activity and user
+func NewActivityMemberTimeReport(userID int, activityID int, activity *model.Activity) *ActivityMemberTimeReport {
+	return &ActivityMemberTimeReport{
+		memberTimeReportGroup: newMemberTimeReportGroup(userID),
+		ActivityID:            activityID,
+		Activity:              activity,
+	}
+}
+
+// ActivityName returns the activity name or an empty string if the activity is unknown
+func (tr *ActivityMemberTimeReport) ActivityName() string {
+	if tr.Activity == nil {
+		return ""
+	}
+	return tr.Activity.Name
+}
+
+// ActivityMemberTimeReports converts MemberTimeReport to ActivityMemberTimeReport grouped by activity
+func ActivityMemberTimeReports(reports []*model.MemberTimeReport) []*ActivityMemberTimeReport {
+	return breakdown(reports,
+		func(r *model.MemberTimeReport) int { return r.ActivityID },
+		func(r *model.MemberTimeReport) *ActivityMemberTimeReport {
+			return NewActivityMemberTimeReport(r.UserID, r.ActivityID, r.Activity)
+		},
+	)
+}
@@ -0,0 +1,34 @@
+package reporting
+
+import (
+	"testing"
+
+	"github.com/markosamuli/glassfactory/model"
+	"gotest.tools/assert"
+)
+
+func TestActivityMemberTimeReports(t *testing.T) {
+	client := &model.Client{ID: 111, Name: "Test Client"}
+	project := &model.Project{ID: 222, Name: "Test Project", BillableStatus: model.Billable}
+	reports := newTestBreakdownReports()
+	for _, r := range reports {
+		r.Client = client
+		r.Project = project
+	}
+
+	projectReports := ProjectMemberTimeReports(reports)
+	assert.Equal(t, len(projectReports), 1)
+
+	activityReports := projectReports[0].Activities()
+	assert.Equal(t, len(activityReports), 3)
+
+	assert.Equal(t, activityReports[0].ActivityID, 0)
+	assert.Equal(t, activityReports[0].ActivityName(), "")
+
+	assert.Equal(t, activityReports[1].Activity, testDesign)
+	assert.Equal(t, activityReports[1].ActivityName(), "Design")
+	assert.Equal(t, activityReports[1].Actual(), 27.5)
+
+	assert.Equal(t, activityReports[2].ActivityID, testMeetings.ID)
+	assert.Equal(t, activityReports[2].ActivityName(), "Meetings")
+}
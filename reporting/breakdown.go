@@ -0,0 +1,82 @@
+package reporting
+
+import (
+	"sort"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+// memberTimeReportGroup contains the time report data of a team member in one
+// group of a breakdown, e.g. a role or an activity
+type memberTimeReportGroup struct {
+	UserID  int
+	Start   dateutil.Date
+	End     dateutil.Date
+	Reports []*model.MemberTimeReport
+}
+
+func newMemberTimeReportGroup(userID int) memberTimeReportGroup {
+	return memberTimeReportGroup{
+		UserID:  userID,
+		Reports: make([]*model.MemberTimeReport, 0),
+	}
+}
+
+// Append adds time report data to the report
+func (g *memberTimeReportGroup) Append(r *model.MemberTimeReport) {
+	if !g.Start.IsValid() || r.Date.Before(g.Start) {
+		g.Start = r.Date
+	}
+	if !g.End.IsValid() || r.Date.After(g.End) {
+		g.End = r.Date
+	}
+	g.Reports = append(g.Reports, r)
+}
+
+// Planned returns total planned hours
+func (g *memberTimeReportGroup) Planned() float64 {
+	var planned float64
+	for _, r := range g.Reports {
+		planned += r.Planned
+	}
+	return planned
+}
+
+// Actual returns total actual hours
+func (g *memberTimeReportGroup) Actual() float64 {
+	var actual float64
+	for _, r := range g.Reports {
+		actual += r.Actual
+	}
+	return actual
+}
+
+// breakdownReport is a report for one group of a breakdown
+type breakdownReport interface {
+	Append(r *model.MemberTimeReport)
+}
+
+// breakdown groups the time reports by the key and returns the group reports
+// sorted by the key. newReport creates the report for the first time report
+// of each group.
+func breakdown[T breakdownReport](reports []*model.MemberTimeReport, key func(*model.MemberTimeReport) int, newReport func(*model.MemberTimeReport) T) []T {
+	groups := make(map[int]T)
+	keys := make([]int, 0)
+	for _, r := range reports {
+		k := key(r)
+		g, ok := groups[k]
+		if !ok {
+			g = newReport(r)
+			groups[k] = g
+			keys = append(keys, k)
+		}
+		g.Append(r)
+	}
+	sort.Ints(keys)
+	result := make([]T, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, groups[k])
+	}
+	return result
+}
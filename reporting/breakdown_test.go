@@ -0,0 +1,75 @@
+package reporting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+var (
+	testBreakdownStart = time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testDesigner       = &model.Role{ID: 1000, Name: "Designer"}
+	testDeveloper      = &model.Role{ID: 1001, Name: "Developer"}
+	testDesign         = &model.Activity{ID: 1, Name: "Design"}
+	testMeetings       = &model.Activity{ID: 2, Name: "Meetings"}
+)
+
+// newTestBreakdownReports returns daily time reports for designer design work
+// and developer meetings, and a report without a role or an activity
+func newTestBreakdownReports() []*model.MemberTimeReport {
+	var reports []*model.MemberTimeReport
+	for i := 0; i < 5; i++ {
+		d := dateutil.DateOf(testBreakdownStart.AddDate(0, 0, i))
+		reports = append(reports,
+			&model.MemberTimeReport{
+				UserID:     123,
+				RoleID:     testDesigner.ID,
+				Role:       testDesigner,
+				ActivityID: testDesign.ID,
+				Activity:   testDesign,
+				Date:       d,
+				Planned:    6.0,
+				Actual:     5.5,
+			},
+			&model.MemberTimeReport{
+				UserID:     123,
+				RoleID:     testDeveloper.ID,
+				Role:       testDeveloper,
+				ActivityID: testMeetings.ID,
+				Activity:   testMeetings,
+				Date:       d,
+				Planned:    2.0,
+				Actual:     2.5,
+			},
+		)
+	}
+	return append(reports, &model.MemberTimeReport{UserID: 123, Planned: 1.0})
+}
+
+func TestBreakdown(t *testing.T) {
+	groups := breakdown(newTestBreakdownReports(),
+		func(r *model.MemberTimeReport) int { return r.RoleID },
+		func(r *model.MemberTimeReport) *memberTimeReportGroup {
+			g := newMemberTimeReportGroup(r.UserID)
+			return &g
+		},
+	)
+	assert.Equal(t, len(groups), 3)
+
+	// Reports without a key are grouped under zero, sorted first
+	assert.Equal(t, len(groups[0].Reports), 1)
+	assert.Equal(t, groups[0].Planned(), 1.0)
+
+	assert.Equal(t, groups[1].UserID, 123)
+	assert.Equal(t, len(groups[1].Reports), 5)
+	assert.Equal(t, groups[1].Planned(), 30.0)
+	assert.Equal(t, groups[1].Actual(), 27.5)
+	assert.Equal(t, groups[1].Start, dateutil.DateOf(testBreakdownStart))
+	assert.Equal(t, groups[1].End, dateutil.DateOf(testBreakdownStart.AddDate(0, 0, 4)))
+
+	assert.Equal(t, groups[2].Planned(), 10.0)
+	assert.Equal(t, groups[2].Actual(), 12.5)
+}
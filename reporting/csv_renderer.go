@@ -0,0 +1,64 @@
+package reporting
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVRenderer renders time reports as delimiter-separated values with a header row.
+// Totals aren't included so that each row is a single record.
+type CSVRenderer struct {
+	w      *csv.Writer
+	header []string
+	rows   [][]string
+}
+
+// NewCSVRenderer creates a new CSVRenderer writing comma-separated values
+func NewCSVRenderer(w io.Writer, period string) *CSVRenderer {
+	return &CSVRenderer{
+		w: csv.NewWriter(w),
+		header: []string{
+			period,
+			"Billable",
+			"Office",
+			"Client",
+			"Project",
+			"Actual",
+			"Planned",
+			"Diff",
+		},
+	}
+}
+
+// NewTSVRenderer creates a new CSVRenderer writing tab-separated values
+func NewTSVRenderer(w io.Writer, period string) *CSVRenderer {
+	r := NewCSVRenderer(w, period)
+	r.w.Comma = '\t'
+	return r
+}
+
+// Append adds a row to the report
+func (c *CSVRenderer) Append(r *TimeReportRow) {
+	c.rows = append(c.rows, []string{
+		r.Period,
+		r.BillableStatus(),
+		FormatOffice(r.Office),
+		r.Client.Name,
+		r.Project.Name,
+		formatHours(r.Actual),
+		formatHours(r.Planned),
+		formatHours(r.Diff()),
+	})
+}
+
+// Render writes the header and the rows
+func (c *CSVRenderer) Render() error {
+	if err := c.w.Write(c.header); err != nil {
+		return err
+	}
+	if err := c.w.WriteAll(c.rows); err != nil {
+		return err
+	}
+	c.rows = nil
+	return nil
+}
@@ -3,14 +3,12 @@ package reporting
 import (
 	"fmt"
 	"io"
-	"os"
 	"sort"
 	"time"
 
 	"github.com/jinzhu/now"
 	"github.com/markosamuli/glassfactory/model"
 	"github.com/markosamuli/glassfactory/pkg/dateutil"
-	"github.com/olekukonko/tablewriter"
 )
 
 // FiscalYear represents a time range for a fiscal year
@@ -103,46 +101,32 @@ func NewFiscalYearMemberTimeReport(userID int, fy FiscalYear) *FiscalYearMemberT
 	}
 }
 
-// RenderTable displays FiscalYearMemberTimeReport in using NewFiscalYearTimeReportTableWriter
-func (tr *FiscalYearMemberTimeReport) RenderTable(writer io.Writer) {
-	reportGroups := make(map[string][]*FiscalYearTimeReport)
+// Period returns the fiscal year of the report
+func (tr *FiscalYearMemberTimeReport) Period() string {
+	return tr.FiscalYear.String()
+}
 
-	projectReports := ProjectMemberTimeReports(tr.Reports)
-	for _, pr := range projectReports {
-		r := &FiscalYearTimeReport{
-			FiscalYear: tr.FiscalYear,
-			Client:     pr.Client,
-			Project:    pr.Project,
-			Planned:    pr.Planned(),
-			Actual:     pr.Actual(),
-		}
-		billableStatus := r.BillableStatus()
-		br, ok := reportGroups[billableStatus]
-		if !ok {
-			br = make([]*FiscalYearTimeReport, 0)
-		}
-		br = append(br, r)
-		reportGroups[billableStatus] = br
-	}
+// Rows returns the project totals of the fiscal year sorted by billable status, client and project
+func (tr *FiscalYearMemberTimeReport) Rows() []*TimeReportRow {
+	return timeReportRows(tr.Period(), tr.Reports)
+}
 
-	table := NewFiscalYearTimeReportTableWriter(writer)
-	for _, r := range reportGroups {
-		sort.SliceStable(r, func(i, j int) bool {
-			if r[i].Client.ID != r[j].Client.ID {
-				return r[i].Client.ID < r[i].Client.ID
-			}
-			if r[i].Client.OfficeID != r[j].Client.OfficeID {
-				return r[i].Client.OfficeID < r[i].Client.OfficeID
-			}
-			if r[i].Project.ID != r[j].Project.ID {
-				return r[i].Project.ID < r[i].Project.ID
-			}
-			return true
-		})
-		for _, tr := range r {
-			table.Append(tr)
-		}
+// Projects returns the time reports of the fiscal year grouped by project
+func (tr *FiscalYearMemberTimeReport) Projects() []*ProjectMemberTimeReport {
+	return ProjectMemberTimeReports(tr.Reports)
+}
+
+// AppendTo appends the project totals of the fiscal year to the renderer
+func (tr *FiscalYearMemberTimeReport) AppendTo(r Renderer) {
+	for _, row := range tr.Rows() {
+		r.Append(row)
 	}
+}
+
+// RenderTable displays FiscalYearMemberTimeReport in a table format
+func (tr *FiscalYearMemberTimeReport) RenderTable(writer io.Writer) {
+	table := NewTableRenderer(writer, "Fiscal Year")
+	tr.AppendTo(table)
 	table.Render()
 }
 
@@ -151,7 +135,7 @@ func (tr *FiscalYearMemberTimeReport) Append(r *model.MemberTimeReport) {
 	if !tr.Start.IsValid() || r.Date.Before(tr.Start) {
 		tr.Start = r.Date
 	}
-	if !tr.End.IsValid() || r.Date.Before(tr.End) {
+	if !tr.End.IsValid() || r.Date.After(tr.End) {
 		tr.End = r.Date
 	}
 	tr.Reports = append(tr.Reports, r)
@@ -178,6 +162,7 @@ func (tr *FiscalYearMemberTimeReport) Actual() float64 {
 // FiscalYearTimeReport represents fiscal year totals for a given client and project
 type FiscalYearTimeReport struct {
 	FiscalYear FiscalYear
+	Office     *model.Office
 	Client     *model.Client
 	Project    *model.Project
 	Planned    float64
@@ -189,79 +174,36 @@ func (r *FiscalYearTimeReport) BillableStatus() string {
 	return FormatBillableStatus(r.Project.BillableStatus)
 }
 
+// Row returns the report as a TimeReportRow
+func (r *FiscalYearTimeReport) Row() *TimeReportRow {
+	return &TimeReportRow{
+		Period:  r.FiscalYear.String(),
+		Office:  r.Office,
+		Client:  r.Client,
+		Project: r.Project,
+		Planned: r.Planned,
+		Actual:  r.Actual,
+	}
+}
+
 // FiscalYearTimeReportTableWriter is used for displaying reports in table format
 type FiscalYearTimeReportTableWriter struct {
-	table  *tablewriter.Table
-	totals map[string]*TimeReportTotals
+	renderer *TableRenderer
 }
 
 // NewFiscalYearTimeReportTableWriter creates a new FiscalYearTimeReportTableWriter
 func NewFiscalYearTimeReportTableWriter(writer io.Writer) *FiscalYearTimeReportTableWriter {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{
-		"Fiscal Year",
-		"Billable",
-		"Client",
-		"Project",
-		"Actual",
-		"Planned",
-		"Diff",
-	})
-	table.SetAutoMergeCells(false)
-	table.SetRowLine(true)
 	return &FiscalYearTimeReportTableWriter{
-		table:  table,
-		totals: make(map[string]*TimeReportTotals),
+		renderer: NewTableRenderer(writer, "Fiscal Year"),
 	}
 }
 
 // Append adds FiscalYearTimeReport data to the table and updates the total hours
 func (t *FiscalYearTimeReportTableWriter) Append(r *FiscalYearTimeReport) {
-	billable := r.BillableStatus()
-	t.table.Append([]string{
-		fmt.Sprintf("%s", r.FiscalYear),
-		billable,
-		r.Client.Name,
-		r.Project.Name,
-		fmt.Sprintf("%6.2f ", r.Actual),
-		fmt.Sprintf("%6.2f ", r.Planned),
-		fmt.Sprintf("%6.2f ", r.Actual-r.Planned),
-	})
-	totals, ok := t.totals[billable]
-	if !ok {
-		totals = &TimeReportTotals{planned: 0.0, actual: 0.0}
-	}
-	totals.planned += r.Planned
-	totals.actual += r.Actual
-	t.totals[billable] = totals
+	t.renderer.Append(r.Row())
 }
 
 // Render displays the report data in a table format
 func (t *FiscalYearTimeReportTableWriter) Render() {
-	var planned float64
-	var actual float64
-	for billable, totals := range t.totals {
-		totalHeader := fmt.Sprintf("Total %s", billable)
-		t.table.Append([]string{
-			"",
-			"",
-			"",
-			totalHeader,
-			fmt.Sprintf("%6.2f ", totals.actual),
-			fmt.Sprintf("%6.2f ", totals.planned),
-			fmt.Sprintf("%6.2f ", totals.actual-totals.planned),
-		})
-		planned += totals.planned
-		actual += totals.actual
-	}
-	t.table.SetFooter([]string{
-		"",
-		"",
-		"",
-		"Total",
-		fmt.Sprintf("%6.2f ", actual),
-		fmt.Sprintf("%6.2f ", planned),
-		fmt.Sprintf("%6.2f ", actual-planned),
-	})
-	t.table.Render()
+	t.renderer.Render()
 }
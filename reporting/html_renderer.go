@@ -0,0 +1,105 @@
+package reporting
+
+import (
+	"html/template"
+	"io"
+	"math"
+)
+
+const (
+	// overPlannedStyle highlights rows with fewer actual hours than planned
+	overPlannedStyle = "background-color: #fff4e5;"
+	// underPlannedStyle highlights rows with more actual hours than planned
+	underPlannedStyle = "background-color: #fdecea;"
+)
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"hours":  formatHours,
+	"office": FormatOffice,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 14px; color: #24292e; }
+table { border-collapse: collapse; margin-bottom: 24px; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; }
+td.hours, th.hours { text-align: right; font-variant-numeric: tabular-nums; }
+tr.subtotal td, tr.total td { font-weight: bold; }
+tr.total td { border-top: 2px solid #24292e; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{- range .Periods }}
+<h2>{{ $.Period }} {{ .Period }}</h2>
+<table>
+<thead>
+<tr><th>Billable</th><th>Office</th><th>Client</th><th>Project</th><th class="hours">Actual</th><th class="hours">Planned</th><th class="hours">Diff</th></tr>
+</thead>
+<tbody>
+{{- range .Groups }}
+{{- range .Rows }}
+<tr{{ with call $.Style . }} style="{{ . }}"{{ end }}><td>{{ .BillableStatus }}</td><td>{{ office .Office }}</td><td>{{ .Client.Name }}</td><td>{{ .Project.Name }}</td><td class="hours">{{ hours .Actual }}</td><td class="hours">{{ hours .Planned }}</td><td class="hours">{{ hours .Diff }}</td></tr>
+{{- end }}
+<tr class="subtotal"><td colspan="4">Total {{ .Billable }}</td><td class="hours">{{ hours .Actual }}</td><td class="hours">{{ hours .Planned }}</td><td class="hours">{{ hours .Diff }}</td></tr>
+{{- end }}
+<tr class="total"><td colspan="4">Total</td><td class="hours">{{ hours .Actual }}</td><td class="hours">{{ hours .Planned }}</td><td class="hours">{{ hours .Diff }}</td></tr>
+</tbody>
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+// HTMLRenderer renders time reports as a self-contained HTML document with a
+// table for each period and subtotals for each billable status
+type HTMLRenderer struct {
+	w       io.Writer
+	period  string
+	options *RenderOptions
+	rows    []*TimeReportRow
+}
+
+// NewHTMLRenderer creates a new HTMLRenderer
+func NewHTMLRenderer(w io.Writer, period string, opts ...RenderOption) *HTMLRenderer {
+	return &HTMLRenderer{
+		w:       w,
+		period:  period,
+		options: NewRenderOptions(opts),
+	}
+}
+
+// Append adds a row to the report
+func (h *HTMLRenderer) Append(r *TimeReportRow) {
+	h.rows = append(h.rows, r)
+}
+
+// Render writes the HTML document
+func (h *HTMLRenderer) Render() error {
+	data := struct {
+		Title   string
+		Period  string
+		Periods []*periodGroup
+		Style   func(r *TimeReportRow) template.CSS
+	}{
+		Title:   h.options.title,
+		Period:  h.period,
+		Periods: groupRows(h.rows),
+		Style:   h.rowStyle,
+	}
+	h.rows = nil
+	return htmlTemplate.Execute(h.w, data)
+}
+
+// rowStyle returns the inline style highlighting over- and under-planned rows
+func (h *HTMLRenderer) rowStyle(r *TimeReportRow) template.CSS {
+	if !h.options.highlight || math.Abs(r.Diff()) <= h.options.threshold {
+		return ""
+	}
+	if r.Diff() < 0 {
+		return overPlannedStyle
+	}
+	return underPlannedStyle
+}
@@ -0,0 +1,44 @@
+package reporting
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/markosamuli/glassfactory/model"
+	"gotest.tools/assert"
+)
+
+func TestHTMLRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatHTML, &buf, "Month", WithTitle("January <2020>"))
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+
+	out := buf.String()
+	assert.Assert(t, strings.HasPrefix(out, "<!DOCTYPE html>"), out)
+	assert.Assert(t, strings.Contains(out, "<title>January &lt;2020&gt;</title>"), out)
+	assert.Assert(t, strings.Contains(out, "<h2>Month 2020-01</h2>"), out)
+	assert.Assert(t, strings.Contains(out, "<td>ACME, Inc.</td>"), out)
+	assert.Assert(t, strings.Contains(out, `<tr class="subtotal"><td colspan="4">Total Billable</td><td class="hours">10.50</td>`), out)
+	assert.Assert(t, strings.Contains(out, `<tr class="total"><td colspan="4">Total</td><td class="hours">15.50</td>`), out)
+	assert.Assert(t, !strings.Contains(out, "style=\""), out)
+	assert.Assert(t, !strings.Contains(out, "<link"), "HTML should be self-contained")
+}
+
+func TestHTMLRenderer_Highlight(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewHTMLRenderer(&buf, "Month", WithHighlight(1))
+	project := &model.Project{ID: 1, Name: "Website", BillableStatus: model.Billable}
+	client := &model.Client{ID: 1, Name: "ACME"}
+	r.Append(&TimeReportRow{Period: "2020-01", Client: client, Project: project, Planned: 10, Actual: 5})
+	r.Append(&TimeReportRow{Period: "2020-01", Client: client, Project: project, Planned: 10, Actual: 15})
+	r.Append(&TimeReportRow{Period: "2020-01", Client: client, Project: project, Planned: 10, Actual: 10.5})
+	assert.NilError(t, r.Render())
+
+	out := buf.String()
+	assert.Assert(t, strings.Contains(out, `<tr style="`+overPlannedStyle+`"><td>Billable</td><td></td><td>ACME</td><td>Website</td><td class="hours">5.00</td>`), out)
+	assert.Assert(t, strings.Contains(out, `<tr style="`+underPlannedStyle+`"><td>Billable</td><td></td><td>ACME</td><td>Website</td><td class="hours">15.00</td>`), out)
+	assert.Assert(t, strings.Contains(out, `<tr><td>Billable</td><td></td><td>ACME</td><td>Website</td><td class="hours">10.50</td>`), out)
+}
@@ -0,0 +1,136 @@
+package reporting
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+// TimeReportSummary is the JSON representation of the time reports in a period
+type TimeReportSummary struct {
+	Period   string                `json:"period"`
+	Projects []*ProjectTimeSummary `json:"projects"`
+	Totals   []*BillableTotal      `json:"totals"` // Totals for each billable status
+	Actual   float64               `json:"actual"`
+	Planned  float64               `json:"planned"`
+	Diff     float64               `json:"diff"`
+}
+
+// ProjectTimeSummary is the JSON representation of the project totals in a period
+type ProjectTimeSummary struct {
+	Billable  string  `json:"billable"`
+	OfficeID  int     `json:"office_id,omitempty"`
+	Office    string  `json:"office,omitempty"`
+	ClientID  int     `json:"client_id"`
+	Client    string  `json:"client"`
+	ProjectID int     `json:"project_id"`
+	Project   string  `json:"project"`
+	Actual    float64 `json:"actual"`
+	Planned   float64 `json:"planned"`
+	Diff      float64 `json:"diff"`
+}
+
+// BillableTotal is the JSON representation of the totals for a billable status
+type BillableTotal struct {
+	Billable string  `json:"billable"`
+	Actual   float64 `json:"actual"`
+	Planned  float64 `json:"planned"`
+	Diff     float64 `json:"diff"`
+}
+
+// Summarize groups the rows by period into summaries with totals for each
+// billable status. The summaries are in the order the periods first appear.
+func Summarize(rows []*TimeReportRow) []*TimeReportSummary {
+	summaries := make([]*TimeReportSummary, 0)
+	periods := make(map[string]*TimeReportSummary)
+	totals := make(map[string]*billableTotals)
+	for _, r := range rows {
+		s, ok := periods[r.Period]
+		if !ok {
+			s = &TimeReportSummary{
+				Period:   r.Period,
+				Projects: make([]*ProjectTimeSummary, 0),
+			}
+			periods[r.Period] = s
+			totals[r.Period] = newBillableTotals()
+			summaries = append(summaries, s)
+		}
+		p := &ProjectTimeSummary{
+			Billable:  r.BillableStatus(),
+			Office:    FormatOffice(r.Office),
+			ClientID:  r.Client.ID,
+			Client:    r.Client.Name,
+			ProjectID: r.Project.ID,
+			Project:   r.Project.Name,
+			Actual:    roundHours(r.Actual),
+			Planned:   roundHours(r.Planned),
+			Diff:      roundHours(r.Diff()),
+		}
+		if r.Office != nil {
+			p.OfficeID = r.Office.ID
+		}
+		s.Projects = append(s.Projects, p)
+		totals[r.Period].Add(r)
+	}
+	for _, s := range summaries {
+		t := totals[s.Period]
+		s.Totals = make([]*BillableTotal, 0, len(t.statuses))
+		for _, billable := range t.statuses {
+			bt := t.totals[billable]
+			s.Totals = append(s.Totals, &BillableTotal{
+				Billable: billable,
+				Actual:   roundHours(bt.actual),
+				Planned:  roundHours(bt.planned),
+				Diff:     roundHours(bt.actual - bt.planned),
+			})
+		}
+		s.Actual = roundHours(t.all.actual)
+		s.Planned = roundHours(t.all.planned)
+		s.Diff = roundHours(t.all.actual - t.all.planned)
+	}
+	return summaries
+}
+
+// roundHours rounds hours to two decimals
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
+
+// JSONRenderer renders time reports as JSON summaries for each period
+type JSONRenderer struct {
+	w     io.Writer
+	lines bool
+	rows  []*TimeReportRow
+}
+
+// NewJSONRenderer creates a new JSONRenderer writing an array of summaries
+func NewJSONRenderer(w io.Writer) *JSONRenderer {
+	return &JSONRenderer{w: w}
+}
+
+// NewJSONLinesRenderer creates a new JSONRenderer writing a summary per line
+func NewJSONLinesRenderer(w io.Writer) *JSONRenderer {
+	return &JSONRenderer{w: w, lines: true}
+}
+
+// Append adds a row to the report
+func (j *JSONRenderer) Append(r *TimeReportRow) {
+	j.rows = append(j.rows, r)
+}
+
+// Render writes the summaries
+func (j *JSONRenderer) Render() error {
+	summaries := Summarize(j.rows)
+	j.rows = nil
+	enc := json.NewEncoder(j.w)
+	if j.lines {
+		for _, s := range summaries {
+			if err := enc.Encode(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	enc.SetIndent("", "  ")
+	return enc.Encode(summaries)
+}
@@ -0,0 +1,131 @@
+package reporting
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func TestJSONRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatJSON, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+
+	// The JSON schema is part of the public interface, changes will break consumers
+	assert.Equal(t, buf.String(), `[
+  {
+    "period": "2020-01",
+    "projects": [
+      {
+        "billable": "Billable",
+        "office_id": 10,
+        "office": "London",
+        "client_id": 1,
+        "client": "ACME, Inc.",
+        "project_id": 200,
+        "project": "Website",
+        "actual": 10.5,
+        "planned": 12,
+        "diff": -1.5
+      },
+      {
+        "billable": "Non Billable",
+        "office_id": 10,
+        "office": "London",
+        "client_id": 3,
+        "client": "Internal",
+        "project_id": 203,
+        "project": "Admin",
+        "actual": 5,
+        "planned": 4,
+        "diff": 1
+      }
+    ],
+    "totals": [
+      {
+        "billable": "Billable",
+        "actual": 10.5,
+        "planned": 12,
+        "diff": -1.5
+      },
+      {
+        "billable": "Non Billable",
+        "actual": 5,
+        "planned": 4,
+        "diff": 1
+      }
+    ],
+    "actual": 15.5,
+    "planned": 16,
+    "diff": -0.5
+  }
+]
+`)
+}
+
+func TestJSONLinesRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatJSONLines, &buf, "Fiscal Year")
+	assert.NilError(t, err)
+
+	client := &model.Client{ID: 1, Name: "ACME"}
+	project := &model.Project{ID: 200, Name: "Website", BillableStatus: model.Billable}
+	reports := make([]*model.MemberTimeReport, 0)
+	for _, d := range []time.Time{
+		time.Date(2019, time.December, 31, 0, 0, 0, 0, time.Local),
+		time.Date(2020, time.February, 3, 0, 0, 0, 0, time.Local),
+	} {
+		reports = append(reports, &model.MemberTimeReport{
+			UserID:  100,
+			Date:    dateutil.DateOf(d),
+			Client:  client,
+			Project: project,
+			Planned: 0.1,
+			Actual:  0.2,
+		})
+	}
+	for _, fy := range FiscalYearMemberTimeReports(reports, time.January) {
+		fy.AppendTo(r)
+	}
+	assert.NilError(t, r.Render())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Equal(t, lines[0], `{"period":"FY 2020","projects":[{"billable":"Billable","client_id":1,"client":"ACME","project_id":200,"project":"Website","actual":0.2,"planned":0.1,"diff":0.1}],"totals":[{"billable":"Billable","actual":0.2,"planned":0.1,"diff":0.1}],"actual":0.2,"planned":0.1,"diff":0.1}`)
+
+	var summary TimeReportSummary
+	assert.NilError(t, json.Unmarshal([]byte(lines[1]), &summary))
+	assert.Equal(t, summary.Period, "FY 2021")
+}
+
+func TestProjectMemberTimeReport_JSON(t *testing.T) {
+	client := &model.Client{ID: 1, Name: "ACME"}
+	project := &model.Project{ID: 200, Name: "Website", BillableStatus: model.NewBusiness}
+	pr := NewProjectMemberTimeReport(100, client, project)
+	for day := 6; day <= 8; day++ {
+		pr.Append(&model.MemberTimeReport{
+			Date:    dateutil.DateOf(time.Date(2020, time.January, day, 0, 0, 0, 0, time.UTC)),
+			Planned: 8,
+			Actual:  7.5,
+		})
+	}
+
+	var buf bytes.Buffer
+	r := NewJSONLinesRenderer(&buf)
+	pr.AppendTo(r)
+	assert.NilError(t, r.Render())
+
+	var summary TimeReportSummary
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &summary))
+	assert.Equal(t, summary.Period, "2020-01-06/2020-01-08")
+	assert.Equal(t, summary.Totals[0].Billable, "New Business")
+	assert.Equal(t, summary.Diff, -1.5)
+}
@@ -0,0 +1,79 @@
+package reporting
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownRenderer renders time reports as a Markdown table for each period
+// with subtotals for each billable status
+type MarkdownRenderer struct {
+	w      io.Writer
+	period string
+	rows   []*TimeReportRow
+}
+
+// NewMarkdownRenderer creates a new MarkdownRenderer
+func NewMarkdownRenderer(w io.Writer, period string) *MarkdownRenderer {
+	return &MarkdownRenderer{w: w, period: period}
+}
+
+// Append adds a row to the report
+func (m *MarkdownRenderer) Append(r *TimeReportRow) {
+	m.rows = append(m.rows, r)
+}
+
+// Render writes the Markdown tables
+func (m *MarkdownRenderer) Render() error {
+	w := bufio.NewWriter(m.w)
+	for i, p := range groupRows(m.rows) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "## %s %s\n\n", m.period, escapeMarkdown(p.Period))
+		fmt.Fprintln(w, "| Billable | Office | Client | Project | Actual | Planned | Diff |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- | ---: | ---: | ---: |")
+		for _, g := range p.Groups {
+			for _, r := range g.Rows {
+				fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s | %s |\n",
+					escapeMarkdown(g.Billable),
+					escapeMarkdown(FormatOffice(r.Office)),
+					escapeMarkdown(r.Client.Name),
+					escapeMarkdown(r.Project.Name),
+					formatHours(r.Actual),
+					formatHours(r.Planned),
+					formatHours(r.Diff()),
+				)
+			}
+			fmt.Fprintf(w, "| | | | **Total %s** | **%s** | **%s** | **%s** |\n",
+				escapeMarkdown(g.Billable),
+				formatHours(g.Actual),
+				formatHours(g.Planned),
+				formatHours(g.Diff()),
+			)
+		}
+		fmt.Fprintf(w, "| | | | **Total** | **%s** | **%s** | **%s** |\n",
+			formatHours(p.Actual),
+			formatHours(p.Planned),
+			formatHours(p.Diff()),
+		)
+	}
+	m.rows = nil
+	return w.Flush()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	`*`, `\*`,
+	`_`, `\_`,
+	"`", "\\`",
+	"\n", " ",
+)
+
+// escapeMarkdown escapes characters with a special meaning in Markdown tables
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,30 @@
+package reporting
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestMarkdownRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatMarkdown, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+	assert.Equal(t, buf.String(), `## Month 2020-01
+
+| Billable | Office | Client | Project | Actual | Planned | Diff |
+| --- | --- | --- | --- | ---: | ---: | ---: |
+| Billable | London | ACME, Inc. | Website | 10.50 | 12.00 | -1.50 |
+| | | | **Total Billable** | **10.50** | **12.00** | **-1.50** |
+| Non Billable | London | Internal | Admin | 5.00 | 4.00 | 1.00 |
+| | | | **Total Non Billable** | **5.00** | **4.00** | **1.00** |
+| | | | **Total** | **15.50** | **16.00** | **-0.50** |
+`)
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	assert.Equal(t, escapeMarkdown("Design | Build *v2*"), `Design \| Build \*v2\*`)
+}
@@ -1,14 +1,11 @@
 package reporting
 
 import (
-	"fmt"
 	"io"
-	"os"
 	"sort"
 
 	"github.com/markosamuli/glassfactory/model"
 	"github.com/markosamuli/glassfactory/pkg/dateutil"
-	"github.com/olekukonko/tablewriter"
 )
 
 // MonthlyMemberTimeReport represents user's time report data for a given month
@@ -34,7 +31,7 @@ func (tr *MonthlyMemberTimeReport) Append(r *model.MemberTimeReport) {
 	if !tr.Start.IsValid() || r.Date.Before(tr.Start) {
 		tr.Start = r.Date
 	}
-	if !tr.End.IsValid() || r.Date.Before(tr.End) {
+	if !tr.End.IsValid() || r.Date.After(tr.End) {
 		tr.End = r.Date
 	}
 	tr.Reports = append(tr.Reports, r)
@@ -91,6 +88,7 @@ func (a ByCalendarMonth) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 // MonthlyTimeReport represents time report data for a calendar month
 type MonthlyTimeReport struct {
 	CalendarMonth CalendarMonth
+	Office        *model.Office
 	Client        *model.Client
 	Project       *model.Project
 	Planned       float64
@@ -102,121 +100,65 @@ func (r *MonthlyTimeReport) BillableStatus() string {
 	return FormatBillableStatus(r.Project.BillableStatus)
 }
 
+// Row returns the report as a TimeReportRow
+func (r *MonthlyTimeReport) Row() *TimeReportRow {
+	return &TimeReportRow{
+		Period:  r.CalendarMonth.String(),
+		Office:  r.Office,
+		Client:  r.Client,
+		Project: r.Project,
+		Planned: r.Planned,
+		Actual:  r.Actual,
+	}
+}
+
 // MonthlyTimeReportTableWriter is used for displaying monthly time report data in a table format
 type MonthlyTimeReportTableWriter struct {
-	table  *tablewriter.Table
-	totals map[string]*TimeReportTotals
+	renderer *TableRenderer
 }
 
 // NewMonthlyTimeReportTableWriter creates a new MonthlyTimeReportTableWriter
 func NewMonthlyTimeReportTableWriter(writer io.Writer) *MonthlyTimeReportTableWriter {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{
-		"Month",
-		"Billable",
-		"Client",
-		"Project",
-		"Actual",
-		"Planned",
-		"Diff",
-	})
-	table.SetAutoMergeCells(false)
-	table.SetRowLine(true)
 	return &MonthlyTimeReportTableWriter{
-		table:  table,
-		totals: make(map[string]*TimeReportTotals),
+		renderer: NewTableRenderer(writer, "Month"),
 	}
 }
 
 // Append adds time report data to the table and updates the report totals
 func (t *MonthlyTimeReportTableWriter) Append(r *MonthlyTimeReport) {
-	billable := r.BillableStatus()
-	t.table.Append([]string{
-		fmt.Sprintf("%s", r.CalendarMonth),
-		billable,
-		r.Client.Name,
-		r.Project.Name,
-		fmt.Sprintf("%6.2f ", r.Actual),
-		fmt.Sprintf("%6.2f ", r.Planned),
-		fmt.Sprintf("%6.2f ", r.Actual-r.Planned),
-	})
-	totals, ok := t.totals[billable]
-	if !ok {
-		totals = &TimeReportTotals{planned: 0.0, actual: 0.0}
-	}
-	totals.planned += r.Planned
-	totals.actual += r.Actual
-	t.totals[billable] = totals
+	t.renderer.Append(r.Row())
 }
 
 // Render displays the time report data in a table format
 func (t *MonthlyTimeReportTableWriter) Render() {
-	var planned float64
-	var actual float64
-	for billable, totals := range t.totals {
-		totalHeader := fmt.Sprintf("Total %s", billable)
-		t.table.Append([]string{
-			"",
-			"",
-			"",
-			totalHeader,
-			fmt.Sprintf("%6.2f ", totals.actual),
-			fmt.Sprintf("%6.2f ", totals.planned),
-			fmt.Sprintf("%6.2f ", totals.actual-totals.planned),
-		})
-		planned += totals.planned
-		actual += totals.actual
+	t.renderer.Render()
+}
+
+// Period returns the calendar month of the report
+func (tr *MonthlyMemberTimeReport) Period() string {
+	return tr.CalendarMonth.String()
+}
+
+// Rows returns the project totals of the month sorted by billable status, client and project
+func (tr *MonthlyMemberTimeReport) Rows() []*TimeReportRow {
+	return timeReportRows(tr.Period(), tr.Reports)
+}
+
+// Projects returns the time reports of the month grouped by project
+func (tr *MonthlyMemberTimeReport) Projects() []*ProjectMemberTimeReport {
+	return ProjectMemberTimeReports(tr.Reports)
+}
+
+// AppendTo appends the project totals of the month to the renderer
+func (tr *MonthlyMemberTimeReport) AppendTo(r Renderer) {
+	for _, row := range tr.Rows() {
+		r.Append(row)
 	}
-	t.table.SetFooter([]string{
-		"",
-		"",
-		"",
-		"Total",
-		fmt.Sprintf("%6.2f ", actual),
-		fmt.Sprintf("%6.2f ", planned),
-		fmt.Sprintf("%6.2f ", actual-planned),
-	})
-	t.table.Render()
 }
 
 // RenderTable renders monthly time report data in a table format
 func (tr *MonthlyMemberTimeReport) RenderTable(writer io.Writer) {
-	reportGroups := make(map[string][]*MonthlyTimeReport)
-	projectReports := ProjectMemberTimeReports(tr.Reports)
-	for _, pr := range projectReports {
-		r := &MonthlyTimeReport{
-			CalendarMonth: tr.CalendarMonth,
-			Client:        pr.Client,
-			Project:       pr.Project,
-			Planned:       pr.Planned(),
-			Actual:        pr.Actual(),
-		}
-		billableStatus := r.BillableStatus()
-		br, ok := reportGroups[billableStatus]
-		if !ok {
-			br = make([]*MonthlyTimeReport, 0)
-		}
-		br = append(br, r)
-		reportGroups[billableStatus] = br
-	}
-
-	table := NewMonthlyTimeReportTableWriter(writer)
-	for _, r := range reportGroups {
-		sort.SliceStable(r, func(i, j int) bool {
-			if r[i].Client.ID != r[j].Client.ID {
-				return r[i].Client.ID < r[i].Client.ID
-			}
-			if r[i].Client.OfficeID != r[j].Client.OfficeID {
-				return r[i].Client.OfficeID < r[i].Client.OfficeID
-			}
-			if r[i].Project.ID != r[j].Project.ID {
-				return r[i].Project.ID < r[i].Project.ID
-			}
-			return true
-		})
-		for _, tr := range r {
-			table.Append(tr)
-		}
-	}
+	table := NewTableRenderer(writer, "Month")
+	tr.AppendTo(table)
 	table.Render()
 }
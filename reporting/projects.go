@@ -10,6 +10,7 @@ import (
 // ProjectMemberTimeReport represents time report data for a given project and team member
 type ProjectMemberTimeReport struct {
 	UserID  int
+	Office  *model.Office
 	Client  *model.Client
 	Project *model.Project
 	Start   dateutil.Date
@@ -32,7 +33,7 @@ func (tr *ProjectMemberTimeReport) Append(r *model.MemberTimeReport) {
 	if !tr.Start.IsValid() || r.Date.Before(tr.Start) {
 		tr.Start = r.Date
 	}
-	if !tr.End.IsValid() || r.Date.Before(tr.End) {
+	if !tr.End.IsValid() || r.Date.After(tr.End) {
 		tr.End = r.Date
 	}
 	tr.Reports = append(tr.Reports, r)
@@ -60,10 +61,12 @@ func (tr *ProjectMemberTimeReport) Actual() float64 {
 func ProjectMemberTimeReports(reports []*model.MemberTimeReport) []*ProjectMemberTimeReport {
 	projects := make(map[int]*ProjectMemberTimeReport, 0)
 	for _, r := range reports {
-		pr, ok := projects[r.Project.ID]
+		project := reportProject(r)
+		pr, ok := projects[project.ID]
 		if !ok {
-			pr = NewProjectMemberTimeReport(r.UserID, r.Client, r.Project)
-			projects[r.Project.ID] = pr
+			pr = NewProjectMemberTimeReport(r.UserID, reportClient(r), project)
+			pr.Office = r.Office
+			projects[project.ID] = pr
 		}
 		pr.Append(r)
 	}
@@ -81,3 +84,26 @@ type ByClient []*ProjectMemberTimeReport
 func (a ByClient) Len() int           { return len(a) }
 func (a ByClient) Less(i, j int) bool { return a[i].Client.ID < a[j].Client.ID }
 func (a ByClient) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+// Row returns the project totals as a TimeReportRow for the period between the
+// first and last report dates
+func (tr *ProjectMemberTimeReport) Row() *TimeReportRow {
+	return &TimeReportRow{
+		Period:  tr.Start.String() + "/" + tr.End.String(),
+		Office:  tr.Office,
+		Client:  tr.Client,
+		Project: tr.Project,
+		Planned: tr.Planned(),
+		Actual:  tr.Actual(),
+	}
+}
+
+// AppendTo appends the project totals to the renderer
+func (tr *ProjectMemberTimeReport) AppendTo(r Renderer) {
+	r.Append(tr.Row())
+}
+
+// Activities returns the project time report data split by activity
+func (tr *ProjectMemberTimeReport) Activities() []*ActivityMemberTimeReport {
+	return ActivityMemberTimeReports(tr.Reports)
+}
@@ -60,6 +60,7 @@ func TestProjectMemberTimeReports(t *testing.T) {
 
 	var reports []*model.MemberTimeReport
 
+	office := &model.Office{ID: 10, Name: "London"}
 	billableClient := &model.Client{ID: 200, Name: "Test Client with multiple projects"}
 	for i := 0; i < 10; i++ {
 		projectID := billableClient.ID + i
@@ -72,6 +73,7 @@ func TestProjectMemberTimeReports(t *testing.T) {
 			UserID:    userID,
 			Client:    billableClient,
 			Project:   project,
+			Office:    office,
 			ClientID:  billableClient.ID,
 			ProjectID: project.ID,
 			Date:      dateutil.DateOf(today),
@@ -109,11 +111,13 @@ func TestProjectMemberTimeReports(t *testing.T) {
 	assert.Equal(t, len(projectReports), 20)
 	for i := 0; i < 10; i++ {
 		assert.Equal(t, projectReports[i].Client.ID, billableClient.ID)
+		assert.Equal(t, projectReports[i].Office, office)
 		assert.Equal(t, projectReports[i].Planned(), 8.0)
 		assert.Equal(t, projectReports[i].Actual(), 7.5)
 	}
 	for i := 0; i < 10; i++ {
 		assert.Equal(t, projectReports[10+i].Client.ID, nonBillableClient.ID)
+		assert.Assert(t, projectReports[10+i].Office == nil)
 		assert.Equal(t, projectReports[10+i].Planned(), 1.0)
 		assert.Equal(t, projectReports[10+i].Actual(), 1.5)
 	}
@@ -0,0 +1,266 @@
+package reporting
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// Format is the output format of the rendered reports
+type Format string
+
+const (
+	// FormatTable renders reports as ASCII tables
+	FormatTable Format = "table"
+	// FormatCSV renders reports as comma-separated values
+	FormatCSV Format = "csv"
+	// FormatTSV renders reports as tab-separated values
+	FormatTSV Format = "tsv"
+	// FormatJSON renders reports as a JSON array of period summaries
+	FormatJSON Format = "json"
+	// FormatJSONLines renders reports as newline-delimited JSON period summaries
+	FormatJSONLines Format = "jsonl"
+	// FormatMarkdown renders reports as Markdown tables
+	FormatMarkdown Format = "markdown"
+	// FormatHTML renders reports as a self-contained HTML document
+	FormatHTML Format = "html"
+	// FormatXLSX renders reports as an Excel workbook
+	FormatXLSX Format = "xlsx"
+)
+
+// Formats returns the supported output formats
+func Formats() []Format {
+	return []Format{FormatTable, FormatCSV, FormatTSV, FormatJSON, FormatJSONLines, FormatMarkdown, FormatHTML, FormatXLSX}
+}
+
+// ParseFormat returns the Format matching the name
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats() {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported format %q", name)
+}
+
+// Renderer writes time report rows in an output format
+type Renderer interface {
+	// Append adds a row to the report
+	Append(r *TimeReportRow)
+	// Render writes the report
+	Render() error
+}
+
+// RenderOptions represent the options of the renderers
+type RenderOptions struct {
+	title     string
+	highlight bool
+	threshold float64
+}
+
+// RenderOption overrides behavior of the renderers
+type RenderOption interface {
+	apply(*RenderOptions)
+}
+
+type renderOptionFunc func(*RenderOptions)
+
+func (f renderOptionFunc) apply(o *RenderOptions) {
+	f(o)
+}
+
+// WithTitle sets the document title of the HTML reports
+func WithTitle(title string) RenderOption {
+	return renderOptionFunc(func(o *RenderOptions) {
+		o.title = title
+	})
+}
+
+// WithHighlight highlights the HTML report rows where actual hours differ
+// from planned hours by more than the threshold
+func WithHighlight(threshold float64) RenderOption {
+	return renderOptionFunc(func(o *RenderOptions) {
+		o.highlight = true
+		o.threshold = threshold
+	})
+}
+
+// NewRenderOptions returns RenderOptions with defaults
+func NewRenderOptions(opts []RenderOption) *RenderOptions {
+	options := &RenderOptions{
+		title: "Time report",
+	}
+	for _, o := range opts {
+		o.apply(options)
+	}
+	return options
+}
+
+// NewRenderer creates a Renderer for the format. The period header names the
+// first column, e.g. "Month".
+func NewRenderer(format Format, w io.Writer, period string, opts ...RenderOption) (Renderer, error) {
+	switch format {
+	case FormatTable, "":
+		return NewTableRenderer(w, period), nil
+	case FormatCSV:
+		return NewCSVRenderer(w, period), nil
+	case FormatTSV:
+		return NewTSVRenderer(w, period), nil
+	case FormatJSON:
+		return NewJSONRenderer(w), nil
+	case FormatJSONLines:
+		return NewJSONLinesRenderer(w), nil
+	case FormatMarkdown:
+		return NewMarkdownRenderer(w, period), nil
+	case FormatHTML:
+		return NewHTMLRenderer(w, period, opts...), nil
+	case FormatXLSX:
+		return NewXLSXRenderer(w, period), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// TimeReportRow represents time report totals for a project in a period
+type TimeReportRow struct {
+	Period  string
+	Office  *model.Office
+	Client  *model.Client
+	Project *model.Project
+	Planned float64
+	Actual  float64
+}
+
+// BillableStatus returns project's billable status
+func (r *TimeReportRow) BillableStatus() string {
+	return FormatBillableStatus(r.Project.BillableStatus)
+}
+
+// Diff returns the difference between actual and planned hours
+func (r *TimeReportRow) Diff() float64 {
+	return r.Actual - r.Planned
+}
+
+// timeReportRows returns the project totals of the reports sorted by billable
+// status, client and project
+func timeReportRows(period string, reports []*model.MemberTimeReport) []*TimeReportRow {
+	projectReports := ProjectMemberTimeReports(reports)
+	rows := make([]*TimeReportRow, 0, len(projectReports))
+	for _, pr := range projectReports {
+		rows = append(rows, &TimeReportRow{
+			Period:  period,
+			Office:  pr.Office,
+			Client:  pr.Client,
+			Project: pr.Project,
+			Planned: pr.Planned(),
+			Actual:  pr.Actual(),
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if a, b := billableOrder(rows[i].Project.BillableStatus), billableOrder(rows[j].Project.BillableStatus); a != b {
+			return a < b
+		}
+		if rows[i].Client.ID != rows[j].Client.ID {
+			return rows[i].Client.ID < rows[j].Client.ID
+		}
+		return rows[i].Project.ID < rows[j].Project.ID
+	})
+	return rows
+}
+
+// billableOrder returns the sort order of the billable status with unknown status last
+func billableOrder(s model.BillableStatus) int {
+	if s == model.Unknown {
+		return int(model.NewBusiness) + 1
+	}
+	return int(s)
+}
+
+// billableTotals calculates the totals of the rows by billable status
+type billableTotals struct {
+	statuses []string
+	totals   map[string]*TimeReportTotals
+	all      TimeReportTotals
+}
+
+func newBillableTotals() *billableTotals {
+	return &billableTotals{totals: make(map[string]*TimeReportTotals)}
+}
+
+// Add adds the row hours to the totals
+func (t *billableTotals) Add(r *TimeReportRow) {
+	billable := r.BillableStatus()
+	totals, ok := t.totals[billable]
+	if !ok {
+		totals = &TimeReportTotals{}
+		t.totals[billable] = totals
+		t.statuses = append(t.statuses, billable)
+	}
+	totals.planned += r.Planned
+	totals.actual += r.Actual
+	t.all.planned += r.Planned
+	t.all.actual += r.Actual
+}
+
+// periodGroup contains the rows of a period grouped by billable status
+type periodGroup struct {
+	Period  string
+	Groups  []*billableGroup
+	Actual  float64
+	Planned float64
+}
+
+// Diff returns the difference between actual and planned hours
+func (g *periodGroup) Diff() float64 {
+	return g.Actual - g.Planned
+}
+
+// billableGroup contains the rows with the same billable status
+type billableGroup struct {
+	Billable string
+	Rows     []*TimeReportRow
+	Actual   float64
+	Planned  float64
+}
+
+// Diff returns the difference between actual and planned hours
+func (g *billableGroup) Diff() float64 {
+	return g.Actual - g.Planned
+}
+
+// groupRows groups the rows by period and billable status in the order they
+// first appear
+func groupRows(rows []*TimeReportRow) []*periodGroup {
+	periods := make([]*periodGroup, 0)
+	byPeriod := make(map[string]*periodGroup)
+	byBillable := make(map[string]map[string]*billableGroup)
+	for _, r := range rows {
+		p, ok := byPeriod[r.Period]
+		if !ok {
+			p = &periodGroup{Period: r.Period}
+			byPeriod[r.Period] = p
+			byBillable[r.Period] = make(map[string]*billableGroup)
+			periods = append(periods, p)
+		}
+		billable := r.BillableStatus()
+		g, ok := byBillable[r.Period][billable]
+		if !ok {
+			g = &billableGroup{Billable: billable}
+			byBillable[r.Period][billable] = g
+			p.Groups = append(p.Groups, g)
+		}
+		g.Rows = append(g.Rows, r)
+		g.Actual += r.Actual
+		g.Planned += r.Planned
+		p.Actual += r.Actual
+		p.Planned += r.Planned
+	}
+	return periods
+}
+
+// formatHours formats hours for machine readable output
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', 2, 64)
+}
@@ -0,0 +1,102 @@
+package reporting
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func newTestMonthlyReport() *MonthlyMemberTimeReport {
+	office := &model.Office{ID: 10, Name: "London"}
+	acme := &model.Client{ID: 1, Name: "ACME, Inc."}
+	internal := &model.Client{ID: 3, Name: "Internal"}
+	website := &model.Project{ID: 200, Name: "Website", BillableStatus: model.Billable}
+	admin := &model.Project{ID: 203, Name: "Admin", BillableStatus: model.NonBillable}
+	mr := NewMonthlyMemberTimeReport(100, CalendarMonth{Year: 2020, Month: time.January})
+	for day := 1; day <= 2; day++ {
+		date := dateutil.DateOf(time.Date(2020, time.January, day, 0, 0, 0, 0, time.UTC))
+		mr.Append(&model.MemberTimeReport{UserID: 100, Date: date, Office: office, Client: internal, Project: admin, Planned: 2, Actual: 2.5})
+		mr.Append(&model.MemberTimeReport{UserID: 100, Date: date, Office: office, Client: acme, Project: website, Planned: 6, Actual: 5.25})
+	}
+	return mr
+}
+
+func TestMonthlyMemberTimeReport_Rows(t *testing.T) {
+	rows := newTestMonthlyReport().Rows()
+	assert.Equal(t, len(rows), 2)
+	assert.Equal(t, rows[0].Period, "2020-01")
+	assert.Equal(t, rows[0].Project.Name, "Website")
+	assert.Equal(t, rows[0].Diff(), -1.5)
+	assert.Equal(t, rows[1].BillableStatus(), "Non Billable")
+}
+
+func TestMonthlyMemberTimeReport_RowsWithoutProject(t *testing.T) {
+	mr := newTestMonthlyReport()
+	date := dateutil.DateOf(time.Date(2020, time.January, 3, 0, 0, 0, 0, time.UTC))
+	mr.Append(&model.MemberTimeReport{UserID: 100, Date: date, Planned: 1, Actual: 1})
+
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatCSV, &buf, "Month")
+	assert.NilError(t, err)
+	mr.AppendTo(r)
+	assert.NilError(t, r.Render())
+	assert.Equal(t, buf.String(), strings.Join([]string{
+		"Month,Billable,Office,Client,Project,Actual,Planned,Diff",
+		`2020-01,Billable,London,"ACME, Inc.",Website,10.50,12.00,-1.50`,
+		"2020-01,Non Billable,London,Internal,Admin,5.00,4.00,1.00",
+		"2020-01,Unknown,,No client,No project,1.00,1.00,0.00",
+		"",
+	}, "\n"))
+}
+
+func TestCSVRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatCSV, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+	assert.Equal(t, buf.String(), strings.Join([]string{
+		"Month,Billable,Office,Client,Project,Actual,Planned,Diff",
+		`2020-01,Billable,London,"ACME, Inc.",Website,10.50,12.00,-1.50`,
+		"2020-01,Non Billable,London,Internal,Admin,5.00,4.00,1.00",
+		"",
+	}, "\n"))
+}
+
+func TestTSVRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatTSV, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+	lines := strings.Split(buf.String(), "\n")
+	assert.Equal(t, lines[0], "Month\tBillable\tOffice\tClient\tProject\tActual\tPlanned\tDiff")
+	assert.Equal(t, lines[1], "2020-01\tBillable\tLondon\tACME, Inc.\tWebsite\t10.50\t12.00\t-1.50")
+}
+
+func TestTableRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatTable, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+	out := buf.String()
+	assert.Assert(t, strings.Contains(out, "Total Billable"), out)
+	assert.Assert(t, strings.Index(out, "Total Billable") < strings.Index(out, "Total Non Billable"), out)
+	assert.Assert(t, strings.Contains(out, "15.50"), out)
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("tsv")
+	assert.NilError(t, err)
+	assert.Equal(t, f, FormatTSV)
+	_, err = ParseFormat("pdf")
+	assert.Error(t, err, `unsupported format "pdf"`)
+	_, err = NewRenderer("pdf", &bytes.Buffer{}, "Month")
+	assert.Error(t, err, `unsupported format "pdf"`)
+}
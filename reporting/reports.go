@@ -8,6 +8,32 @@ type TimeReportTotals struct {
 	planned float64
 }
 
+// FormatOffice returns the office name or an empty string if the office is unknown
+func FormatOffice(office *model.Office) string {
+	if office == nil {
+		return ""
+	}
+	return office.Name
+}
+
+// reportClient returns the client of the report or a placeholder if the report
+// doesn't have a client
+func reportClient(r *model.MemberTimeReport) *model.Client {
+	if r.Client == nil {
+		return &model.Client{ID: r.ClientID, Name: "No client"}
+	}
+	return r.Client
+}
+
+// reportProject returns the project of the report or a placeholder if the report
+// doesn't have a project
+func reportProject(r *model.MemberTimeReport) *model.Project {
+	if r.Project == nil {
+		return &model.Project{ID: r.ProjectID, ClientID: r.ClientID, Name: "No project"}
+	}
+	return r.Project
+}
+
 // FormatBillableStatus returns the BillableStatus field as a string
 func FormatBillableStatus(billableStatus model.BillableStatus) string {
 	return billableStatus.String()
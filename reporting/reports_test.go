@@ -2,8 +2,10 @@ package reporting
 
 import (
 	"testing"
+	"time"
 
 	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
 	"gotest.tools/assert"
 )
 
@@ -32,3 +34,37 @@ func TestFormatBillableStatus(t *testing.T) {
 	}
 	assert.Equal(t, unknownReport.BillableStatus(), "Unknown")
 }
+
+func TestFormatOffice(t *testing.T) {
+	assert.Equal(t, FormatOffice(nil), "")
+	assert.Equal(t, FormatOffice(&model.Office{ID: 1, Name: "London"}), "London")
+}
+
+func TestMemberTimeReport_StartEnd(t *testing.T) {
+	date := func(day int) dateutil.Date {
+		return dateutil.DateOf(time.Date(2020, time.January, day, 0, 0, 0, 0, time.UTC))
+	}
+	client := &model.Client{ID: 1, Name: "ACME"}
+	project := &model.Project{ID: 2, Name: "Website"}
+	// Reports are appended out of order so that the latest date isn't the last one
+	var reports []*model.MemberTimeReport
+	for _, day := range []int{15, 3, 28, 10} {
+		reports = append(reports, &model.MemberTimeReport{UserID: 100, Date: date(day), Client: client, Project: project})
+	}
+
+	monthly := NewMonthlyMemberTimeReport(100, CalendarMonth{Year: 2020, Month: time.January})
+	fiscalYear := NewFiscalYearMemberTimeReport(100, *NewFiscalYear(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.December))
+	projectReport := NewProjectMemberTimeReport(100, client, project)
+	for _, r := range reports {
+		monthly.Append(r)
+		fiscalYear.Append(r)
+		projectReport.Append(r)
+	}
+
+	assert.Equal(t, monthly.Start, date(3))
+	assert.Equal(t, monthly.End, date(28))
+	assert.Equal(t, fiscalYear.Start, date(3))
+	assert.Equal(t, fiscalYear.End, date(28))
+	assert.Equal(t, projectReport.Start, date(3))
+	assert.Equal(t, projectReport.End, date(28))
+}
@@ -0,0 +1,39 @@
+package reporting
+
+import (
+	"github.com/markosamuli/glassfactory/model"
+)
+
+// RoleMemberTimeReport represents time report data for a given role and team member
+type RoleMemberTimeReport struct {
+	memberTimeReportGroup
+	RoleID int
+	Role   *model.Role
+}
+
+// NewRoleMemberTimeReport creates a new RoleMemberTimeReport for the given role and user
+func NewRoleMemberTimeReport(userID int, roleID int, role *model.Role) *RoleMemberTimeReport {
+	return &RoleMemberTimeReport{
+		memberTimeReportGroup: newMemberTimeReportGroup(userID),
+		RoleID:                roleID,
+		Role:                  role,
+	}
+}
+
+// RoleName returns the role name or an empty string if the role is unknown
+func (tr *RoleMemberTimeReport) RoleName() string {
+	if tr.Role == nil {
+		return ""
+	}
+	return tr.Role.Name
+}
+
+// RoleMemberTimeReports converts MemberTimeReport to RoleMemberTimeReport grouped by the role worked
+func RoleMemberTimeReports(reports []*model.MemberTimeReport) []*RoleMemberTimeReport {
+	return breakdown(reports,
+		func(r *model.MemberTimeReport) int { return r.RoleID },
+		func(r *model.MemberTimeReport) *RoleMemberTimeReport {
+			return NewRoleMemberTimeReport(r.UserID, r.RoleID, r.Role)
+		},
+	)
+}
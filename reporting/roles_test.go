@@ -0,0 +1,22 @@
+package reporting
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestRoleMemberTimeReports(t *testing.T) {
+	roleReports := RoleMemberTimeReports(newTestBreakdownReports())
+	assert.Equal(t, len(roleReports), 3)
+
+	assert.Equal(t, roleReports[0].RoleID, 0)
+	assert.Equal(t, roleReports[0].RoleName(), "")
+
+	assert.Equal(t, roleReports[1].Role, testDesigner)
+	assert.Equal(t, roleReports[1].RoleName(), "Designer")
+	assert.Equal(t, roleReports[1].Planned(), 30.0)
+
+	assert.Equal(t, roleReports[2].RoleID, testDeveloper.ID)
+	assert.Equal(t, roleReports[2].RoleName(), "Developer")
+}
@@ -15,12 +15,14 @@ func NewService(ctx context.Context, apiService *api.Service) (*Service, error)
 		return nil, errors.New("apiService is nil")
 	}
 	s := &Service{}
+	s.ctx = ctx
 	s.api = apiService
 	return s, nil
 }
 
 // Service provides methods for fetching time report data from Glass Factory
 type Service struct {
+	ctx context.Context
 	api *api.Service
 }
 
@@ -28,18 +30,49 @@ type Service struct {
 func (s *Service) MonthlyMemberTimeReports(userID int, t time.Time) ([]*MonthlyMemberTimeReport, error) {
 	start := now.With(t).BeginningOfYear()
 	end := now.With(t).EndOfYear()
-	reports, err := s.api.Member.Reports.GetTimeReportsBetweenDates(userID, start, end, api.FetchRelated())
+	reports, err := s.api.Member.Reports.GetTimeReportsBetweenDatesContext(s.ctx, userID, start, end, api.FetchRelated())
 	if err != nil {
 		return nil, err
 	}
 	return MonthlyMemberTimeReports(reports), nil
 }
 
+// EachMonthlyMemberTimeReport streams time reports between the given dates from Glass Factory
+// and calls f with the time report of each calendar month as soon as the month has been fetched
+func (s *Service) EachMonthlyMemberTimeReport(userID int, start time.Time, end time.Time, f func(*MonthlyMemberTimeReport) error) error {
+	var mr *MonthlyMemberTimeReport
+	it := s.api.Member.Reports.Iterate(s.ctx, userID, start, end, api.FetchRelated())
+	for it.Next() {
+		r := it.Report()
+		month := CalendarMonth{
+			Year:  r.Date.Year,
+			Month: r.Date.Month,
+		}
+		if mr != nil && mr.CalendarMonth != month {
+			if err := f(mr); err != nil {
+				return err
+			}
+			mr = nil
+		}
+		if mr == nil {
+			mr = NewMonthlyMemberTimeReport(userID, month)
+		}
+		mr.Append(r)
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if mr != nil {
+		return f(mr)
+	}
+	return nil
+}
+
 // FiscalYearMemberTimeReports queries Glass Factory and returns time reports for the given fiscal year
 func (s *Service) FiscalYearMemberTimeReports(userID int, fiscalYear *FiscalYear) ([]*FiscalYearMemberTimeReport, error) {
 	start := fiscalYear.Start
 	end := fiscalYear.End
-	reports, err := s.api.Member.Reports.GetTimeReportsBetweenDates(userID, start, end, api.FetchRelated())
+	reports, err := s.api.Member.Reports.GetTimeReportsBetweenDatesContext(s.ctx, userID, start, end, api.FetchRelated())
 	if err != nil {
 		return nil, err
 	}
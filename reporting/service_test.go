@@ -9,6 +9,7 @@ import (
 
 	"github.com/jinzhu/now"
 	"github.com/markosamuli/glassfactory/api"
+	"github.com/markosamuli/glassfactory/gftest"
 	"github.com/markosamuli/glassfactory/model"
 	"github.com/markosamuli/glassfactory/pkg/dateutil"
 	"gopkg.in/h2non/gock.v1"
@@ -230,3 +231,31 @@ func TestService_FiscalYearMemberTimeReports(t *testing.T) {
 		assert.Equal(t, r.Reports[0].Project.ID, project.ID)
 	}
 }
+
+func TestService_EachMonthlyMemberTimeReport(t *testing.T) {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.March, 31, 0, 0, 0, 0, time.UTC)
+	srv := gftest.NewServerWithDataset(gftest.SampleDataset(start, end))
+	defer srv.Close()
+
+	ctx := context.Background()
+	apiService, err := api.NewService(ctx, srv.Settings())
+	assert.NilError(t, err)
+	s, err := NewService(ctx, apiService)
+	assert.NilError(t, err)
+
+	months := make([]CalendarMonth, 0)
+	err = s.EachMonthlyMemberTimeReport(100, start, end, func(mr *MonthlyMemberTimeReport) error {
+		months = append(months, mr.CalendarMonth)
+		assert.Equal(t, mr.Reports[0].Project.ID > 0, true)
+		// A month is complete when the reports for the next month arrive
+		assert.Assert(t, srv.RequestCount("members/100/reports/time.json") <= len(months)+1)
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, months, []CalendarMonth{
+		{Year: 2020, Month: time.January},
+		{Year: 2020, Month: time.February},
+		{Year: 2020, Month: time.March},
+	})
+}
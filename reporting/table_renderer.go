@@ -0,0 +1,79 @@
+package reporting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// TableRenderer renders time reports as an ASCII table with totals for each billable status
+type TableRenderer struct {
+	table  *tablewriter.Table
+	totals *billableTotals
+}
+
+// NewTableRenderer creates a new TableRenderer
+func NewTableRenderer(w io.Writer, period string) *TableRenderer {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{
+		period,
+		"Billable",
+		"Office",
+		"Client",
+		"Project",
+		"Actual",
+		"Planned",
+		"Diff",
+	})
+	table.SetAutoMergeCells(false)
+	table.SetRowLine(true)
+	return &TableRenderer{
+		table:  table,
+		totals: newBillableTotals(),
+	}
+}
+
+// Append adds a row to the table and updates the report totals
+func (t *TableRenderer) Append(r *TimeReportRow) {
+	t.table.Append([]string{
+		r.Period,
+		r.BillableStatus(),
+		FormatOffice(r.Office),
+		r.Client.Name,
+		r.Project.Name,
+		fmt.Sprintf("%6.2f ", r.Actual),
+		fmt.Sprintf("%6.2f ", r.Planned),
+		fmt.Sprintf("%6.2f ", r.Diff()),
+	})
+	t.totals.Add(r)
+}
+
+// Render writes the table with the totals
+func (t *TableRenderer) Render() error {
+	for _, billable := range t.totals.statuses {
+		totals := t.totals.totals[billable]
+		t.table.Append([]string{
+			"",
+			"",
+			"",
+			"",
+			fmt.Sprintf("Total %s", billable),
+			fmt.Sprintf("%6.2f ", totals.actual),
+			fmt.Sprintf("%6.2f ", totals.planned),
+			fmt.Sprintf("%6.2f ", totals.actual-totals.planned),
+		})
+	}
+	t.table.SetFooter([]string{
+		"",
+		"",
+		"",
+		"",
+		"Total",
+		fmt.Sprintf("%6.2f ", t.totals.all.actual),
+		fmt.Sprintf("%6.2f ", t.totals.all.planned),
+		fmt.Sprintf("%6.2f ", t.totals.all.actual-t.totals.all.planned),
+	})
+	t.table.Render()
+	return nil
+}
@@ -0,0 +1,127 @@
+package reporting
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+)
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// templateExt is the file extension of the built-in templates
+const templateExt = ".tmpl"
+
+// PeriodReport is a member time report for a month or a fiscal year
+type PeriodReport interface {
+	// Period returns the name of the period
+	Period() string
+	// Planned returns total planned hours
+	Planned() float64
+	// Actual returns total actual hours
+	Actual() float64
+	// Rows returns the project totals sorted by billable status, client and project
+	Rows() []*TimeReportRow
+	// Projects returns the time reports grouped by project
+	Projects() []*ProjectMemberTimeReport
+	// AppendTo appends the project totals to the renderer
+	AppendTo(r Renderer)
+}
+
+// TemplateData is the data passed to report templates
+type TemplateData struct {
+	Period  string // Period header, e.g. "Month"
+	Reports []PeriodReport
+}
+
+// TemplateFuncs returns the helper functions available in report templates
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"hours":    formatHours,
+		"diff":     formatDiff,
+		"billable": FormatBillableStatus,
+		"office":   FormatOffice,
+		"date":     formatDate,
+	}
+}
+
+// formatDiff formats the difference between actual and planned hours with a sign
+func formatDiff(actual, planned float64) string {
+	return fmt.Sprintf("%+.2f", roundHours(actual-planned))
+}
+
+// formatDate formats a date or a time with the layout
+func formatDate(layout string, d interface{}) (string, error) {
+	switch v := d.(type) {
+	case dateutil.Date:
+		return v.In(time.UTC).Format(layout), nil
+	case dateutil.DateTime:
+		return v.In(time.UTC).Format(layout), nil
+	case time.Time:
+		return v.Format(layout), nil
+	}
+	return "", fmt.Errorf("can't format %T as a date", d)
+}
+
+// Template renders time reports with a text/template
+type Template struct {
+	t *template.Template
+}
+
+// ParseTemplateFile parses a report template from a file
+func ParseTemplateFile(filename string) (*Template, error) {
+	t, err := template.New(filepath.Base(filename)).Funcs(TemplateFuncs()).ParseFiles(filename)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{t: t}, nil
+}
+
+// BuiltinTemplate returns a built-in report template by name
+func BuiltinTemplate(name string) (*Template, error) {
+	filename := path.Join("templates", name+templateExt)
+	if _, err := fs.Stat(builtinTemplates, filename); err != nil {
+		return nil, fmt.Errorf("unknown built-in template %q", name)
+	}
+	t, err := template.New(path.Base(filename)).Funcs(TemplateFuncs()).ParseFS(builtinTemplates, filename)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{t: t}, nil
+}
+
+// BuiltinTemplates returns the names of the built-in report templates
+func BuiltinTemplates() []string {
+	files, _ := fs.Glob(builtinTemplates, "templates/*"+templateExt)
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, strings.TrimSuffix(path.Base(f), templateExt))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadTemplate returns the built-in template with the name or parses the
+// template from a file
+func LoadTemplate(name string) (*Template, error) {
+	for _, builtin := range BuiltinTemplates() {
+		if name == builtin {
+			return BuiltinTemplate(name)
+		}
+	}
+	return ParseTemplateFile(name)
+}
+
+// Execute renders the reports with the template
+func (t *Template) Execute(w io.Writer, data *TemplateData) error {
+	return t.t.Execute(w, data)
+}
@@ -0,0 +1,99 @@
+package reporting
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/markosamuli/glassfactory/model"
+	"github.com/markosamuli/glassfactory/pkg/dateutil"
+	"gotest.tools/assert"
+)
+
+func newTestTemplateData() *TemplateData {
+	return &TemplateData{
+		Period:  "Month",
+		Reports: []PeriodReport{newTestMonthlyReport()},
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	assert.Equal(t, formatDiff(10.5, 12), "-1.50")
+	assert.Equal(t, formatDiff(5, 4), "+1.00")
+	assert.Equal(t, formatDiff(4, 4), "+0.00")
+
+	d := dateutil.DateOf(time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC))
+	s, err := formatDate("2 Jan 2006", d)
+	assert.NilError(t, err)
+	assert.Equal(t, s, "2 Jan 2020")
+	s, err = formatDate("2006-01-02", time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC))
+	assert.NilError(t, err)
+	assert.Equal(t, s, "2020-03-01")
+	_, err = formatDate("2006-01-02", "2020-01-01")
+	assert.ErrorContains(t, err, "can't format string as a date")
+}
+
+func TestParseTemplateFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "report.tmpl")
+	tmpl := `{{ range .Reports }}{{ $.Period }} {{ .Period }}
+{{ range .Rows }}{{ .Project.Name }} {{ billable .Project.BillableStatus }} {{ office .Office }} {{ hours .Actual }} {{ diff .Actual .Planned }}
+{{ end }}{{ end }}`
+	assert.NilError(t, os.WriteFile(filename, []byte(tmpl), 0600))
+
+	tr, err := LoadTemplate(filename)
+	assert.NilError(t, err)
+	var buf bytes.Buffer
+	assert.NilError(t, tr.Execute(&buf, newTestTemplateData()))
+	assert.Equal(t, buf.String(), `Month 2020-01
+Website Billable London 10.50 -1.50
+Admin Non Billable London 5.00 +1.00
+`)
+
+	_, err = LoadTemplate(filepath.Join(t.TempDir(), "missing.tmpl"))
+	assert.ErrorContains(t, err, "no such file")
+}
+
+func TestBuiltinTemplates(t *testing.T) {
+	assert.DeepEqual(t, BuiltinTemplates(), []string{"projects", "summary"})
+
+	_, err := BuiltinTemplate("missing")
+	assert.ErrorContains(t, err, `unknown built-in template "missing"`)
+
+	tr, err := LoadTemplate("summary")
+	assert.NilError(t, err)
+	var buf bytes.Buffer
+	assert.NilError(t, tr.Execute(&buf, newTestTemplateData()))
+	assert.Equal(t, buf.String(), `Month 2020-01: 15.50 actual, 16.00 planned (-0.50)
+  Billable       ACME, Inc. / Website: 10.50 (-1.50)
+  Non Billable   Internal / Admin: 5.00 (+1.00)
+`)
+
+	data := newTestTemplateData()
+	activity := &model.Activity{ID: 5, Name: "Development"}
+	for _, r := range data.Reports[0].(*MonthlyMemberTimeReport).Reports {
+		r.Activity = activity
+		r.ActivityID = activity.ID
+	}
+	tr, err = LoadTemplate("projects")
+	assert.NilError(t, err)
+	buf.Reset()
+	assert.NilError(t, tr.Execute(&buf, data))
+	assert.Equal(t, buf.String(), `## Month 2020-01
+
+1 Jan 2020 to 2 Jan 2020: 15.50 actual, 16.00 planned (-0.50)
+
+### ACME, Inc. / Website
+
+Billable: 10.50 actual, 12.00 planned (-1.50)
+
+- Development: 10.50 actual, 12.00 planned
+
+### Internal / Admin
+
+Non Billable: 5.00 actual, 4.00 planned (+1.00)
+
+- Development: 5.00 actual, 4.00 planned
+`)
+}
@@ -0,0 +1,264 @@
+package reporting
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// summarySheet is the name of the sheet with the totals of all periods
+const summarySheet = "Summary"
+
+// maxSheetNameLength is the maximum length of a worksheet name in Excel
+const maxSheetNameLength = 31
+
+// xlsxHeader is the header row of the period sheets
+var xlsxHeader = []interface{}{"Billable", "Office", "Client", "Project", "Actual", "Planned", "Diff"}
+
+// formula is a cell value set as a formula
+type formula string
+
+// XLSXRenderer renders time reports as an Excel workbook with a sheet for each
+// period and a summary sheet. Totals for each billable status are formulas.
+type XLSXRenderer struct {
+	w      io.Writer
+	period string
+	rows   []*TimeReportRow
+}
+
+// NewXLSXRenderer creates a new XLSXRenderer
+func NewXLSXRenderer(w io.Writer, period string) *XLSXRenderer {
+	return &XLSXRenderer{w: w, period: period}
+}
+
+// Append adds a row to the report
+func (x *XLSXRenderer) Append(r *TimeReportRow) {
+	x.rows = append(x.rows, r)
+}
+
+// Render writes the workbook
+func (x *XLSXRenderer) Render() error {
+	f := excelize.NewFile()
+	defer f.Close()
+	styles, err := newXLSXStyles(f)
+	if err != nil {
+		return err
+	}
+	if err := f.SetSheetName(f.GetSheetName(0), summarySheet); err != nil {
+		return err
+	}
+	summary := []interface{}{x.period, "Billable", "Actual", "Planned", "Diff"}
+	if err := setXLSXRow(f, summarySheet, 1, summary, styles.header); err != nil {
+		return err
+	}
+	summaryRow := 2
+	for _, p := range groupRows(x.rows) {
+		sheet := sheetName(p.Period)
+		if _, err := f.NewSheet(sheet); err != nil {
+			return err
+		}
+		subtotals, err := writePeriodSheet(f, sheet, p, styles)
+		if err != nil {
+			return err
+		}
+		for i, g := range p.Groups {
+			ref := quoteSheetName(sheet)
+			values := []interface{}{
+				p.Period,
+				g.Billable,
+				formula(fmt.Sprintf("%s!E%d", ref, subtotals[i])),
+				formula(fmt.Sprintf("%s!F%d", ref, subtotals[i])),
+				formula(fmt.Sprintf("C%d-D%d", summaryRow, summaryRow)),
+			}
+			if err := setXLSXRow(f, summarySheet, summaryRow, values, styles.hours); err != nil {
+				return err
+			}
+			summaryRow++
+		}
+	}
+	last := summaryRow - 1
+	total := []interface{}{
+		"Total",
+		"",
+		formula(fmt.Sprintf("SUM(C2:C%d)", last)),
+		formula(fmt.Sprintf("SUM(D2:D%d)", last)),
+		formula(fmt.Sprintf("C%d-D%d", summaryRow, summaryRow)),
+	}
+	if err := setXLSXRow(f, summarySheet, summaryRow, total, styles.total); err != nil {
+		return err
+	}
+	if err := f.SetColWidth(summarySheet, "A", "B", 16); err != nil {
+		return err
+	}
+	if err := freezeHeader(f, summarySheet); err != nil {
+		return err
+	}
+	x.rows = nil
+	return f.Write(x.w)
+}
+
+// writePeriodSheet writes the rows of the period grouped by billable status
+// and returns the row numbers of the subtotals of each billable group
+func writePeriodSheet(f *excelize.File, sheet string, p *periodGroup, styles *xlsxStyles) ([]int, error) {
+	if err := setXLSXRow(f, sheet, 1, xlsxHeader, styles.header); err != nil {
+		return nil, err
+	}
+	row := 2
+	subtotals := make([]int, 0, len(p.Groups))
+	for _, g := range p.Groups {
+		first := row
+		for _, r := range g.Rows {
+			values := []interface{}{
+				r.BillableStatus(),
+				FormatOffice(r.Office),
+				r.Client.Name,
+				r.Project.Name,
+				r.Actual,
+				r.Planned,
+				formula(fmt.Sprintf("E%d-F%d", row, row)),
+			}
+			if err := setXLSXRow(f, sheet, row, values, styles.hours); err != nil {
+				return nil, err
+			}
+			row++
+		}
+		subtotal := []interface{}{
+			"Total " + g.Billable,
+			"",
+			"",
+			"",
+			formula(fmt.Sprintf("SUM(E%d:E%d)", first, row-1)),
+			formula(fmt.Sprintf("SUM(F%d:F%d)", first, row-1)),
+			formula(fmt.Sprintf("E%d-F%d", row, row)),
+		}
+		if err := setXLSXRow(f, sheet, row, subtotal, styles.total); err != nil {
+			return nil, err
+		}
+		subtotals = append(subtotals, row)
+		row++
+	}
+	total := []interface{}{
+		"Total",
+		"",
+		"",
+		"",
+		formula("SUM(" + columnCells("E", subtotals) + ")"),
+		formula("SUM(" + columnCells("F", subtotals) + ")"),
+		formula(fmt.Sprintf("E%d-F%d", row, row)),
+	}
+	if err := setXLSXRow(f, sheet, row, total, styles.total); err != nil {
+		return nil, err
+	}
+	if err := f.SetColWidth(sheet, "A", "D", 24); err != nil {
+		return nil, err
+	}
+	if err := freezeHeader(f, sheet); err != nil {
+		return nil, err
+	}
+	return subtotals, nil
+}
+
+// columnCells returns a comma-separated list of the cells in the column
+func columnCells(col string, rows []int) string {
+	cells := make([]string, 0, len(rows))
+	for _, row := range rows {
+		cells = append(cells, fmt.Sprintf("%s%d", col, row))
+	}
+	return strings.Join(cells, ",")
+}
+
+// xlsxStyles contains the cell styles of the workbook
+type xlsxStyles struct {
+	header int
+	hours  int
+	total  int
+}
+
+func newXLSXStyles(f *excelize.File) (*xlsxStyles, error) {
+	var err error
+	styles := &xlsxStyles{}
+	styles.header, err = f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Number format 2 displays the hours with two decimals
+	styles.hours, err = f.NewStyle(&excelize.Style{
+		NumFmt: 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+	styles.total, err = f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		NumFmt: 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return styles, nil
+}
+
+// setXLSXRow sets the cell values of the row and applies the style to them
+func setXLSXRow(f *excelize.File, sheet string, row int, values []interface{}, style int) error {
+	for i, v := range values {
+		cell, err := excelize.CoordinatesToCellName(i+1, row)
+		if err != nil {
+			return err
+		}
+		if s, ok := v.(formula); ok {
+			err = f.SetCellFormula(sheet, cell, string(s))
+		} else {
+			err = f.SetCellValue(sheet, cell, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	first, err := excelize.CoordinatesToCellName(1, row)
+	if err != nil {
+		return err
+	}
+	last, err := excelize.CoordinatesToCellName(len(values), row)
+	if err != nil {
+		return err
+	}
+	return f.SetCellStyle(sheet, first, last, style)
+}
+
+// freezeHeader freezes the first row of the sheet
+func freezeHeader(f *excelize.File, sheet string) error {
+	return f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+}
+
+var sheetNameReplacer = strings.NewReplacer(
+	`/`, `-`,
+	`\`, `-`,
+	`?`, ``,
+	`*`, ``,
+	`:`, ``,
+	`[`, `(`,
+	`]`, `)`,
+)
+
+// sheetName returns the period as a valid worksheet name
+func sheetName(period string) string {
+	name := sheetNameReplacer.Replace(period)
+	if len(name) > maxSheetNameLength {
+		name = name[:maxSheetNameLength]
+	}
+	return name
+}
+
+// quoteSheetName quotes the sheet name for formula references
+func quoteSheetName(sheet string) string {
+	return "'" + strings.ReplaceAll(sheet, "'", "''") + "'"
+}
@@ -0,0 +1,86 @@
+package reporting
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+	"gotest.tools/assert"
+)
+
+func TestXLSXRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRenderer(FormatXLSX, &buf, "Month")
+	assert.NilError(t, err)
+	newTestMonthlyReport().AppendTo(r)
+	assert.NilError(t, r.Render())
+
+	f, err := excelize.OpenReader(&buf)
+	assert.NilError(t, err)
+	defer f.Close()
+	assert.DeepEqual(t, f.GetSheetList(), []string{"Summary", "2020-01"})
+
+	rows, err := f.GetRows("2020-01", excelize.Options{RawCellValue: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rows[0], []string{"Billable", "Office", "Client", "Project", "Actual", "Planned", "Diff"})
+	assert.DeepEqual(t, rows[1][:6], []string{"Billable", "London", "ACME, Inc.", "Website", "10.5", "12"})
+	assert.Equal(t, rows[2][0], "Total Billable")
+	assert.Equal(t, rows[4][0], "Total Non Billable")
+	assert.Equal(t, rows[5][0], "Total")
+
+	// Numeric cells are stored without a type attribute unlike strings
+	cellType, err := f.GetCellType("2020-01", "E2")
+	assert.NilError(t, err)
+	assert.Equal(t, cellType, excelize.CellTypeUnset)
+	cellType, err = f.GetCellType("2020-01", "D2")
+	assert.NilError(t, err)
+	assert.Equal(t, cellType, excelize.CellTypeSharedString)
+
+	formula, err := f.GetCellFormula("2020-01", "E3")
+	assert.NilError(t, err)
+	assert.Equal(t, formula, "SUM(E2:E2)")
+	formula, err = f.GetCellFormula("2020-01", "E6")
+	assert.NilError(t, err)
+	assert.Equal(t, formula, "SUM(E3,E5)")
+
+	for cell, expected := range map[string]string{
+		"E3": "10.50",
+		"G3": "-1.50",
+		"E5": "5.00",
+		"E6": "15.50",
+		"F6": "16.00",
+		"G6": "-0.50",
+	} {
+		value, err := f.CalcCellValue("2020-01", cell)
+		assert.NilError(t, err)
+		assert.Equal(t, value, expected, cell)
+	}
+
+	panes, err := f.GetPanes("2020-01")
+	assert.NilError(t, err)
+	assert.Assert(t, panes.Freeze)
+	assert.Equal(t, panes.YSplit, 1)
+
+	summary, err := f.GetRows("Summary")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, summary[0], []string{"Month", "Billable", "Actual", "Planned", "Diff"})
+	assert.DeepEqual(t, summary[1][:2], []string{"2020-01", "Billable"})
+	assert.DeepEqual(t, summary[2][:2], []string{"2020-01", "Non Billable"})
+	for cell, expected := range map[string]string{
+		"C2": "10.50",
+		"D3": "4.00",
+		"C4": "15.50",
+		"E4": "-0.50",
+	} {
+		value, err := f.CalcCellValue("Summary", cell)
+		assert.NilError(t, err)
+		assert.Equal(t, value, expected, cell)
+	}
+}
+
+func TestSheetName(t *testing.T) {
+	assert.Equal(t, sheetName("FY 2020"), "FY 2020")
+	assert.Equal(t, sheetName("2020-01-01/2020-12-31"), "2020-01-01-2020-12-31")
+	assert.Equal(t, len(sheetName("[A very long period name: over 31 characters]")), maxSheetNameLength)
+	assert.Equal(t, quoteSheetName("Bob's"), "'Bob''s'")
+}